@@ -21,3 +21,20 @@ resource "cloudflare_access_application" "staging_app" {
     max_age           = 10
   }
 }
+
+# Infrastructure application for SSH targets
+resource "cloudflare_access_application" "infrastructure_app" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "infrastructure application"
+  type       = "infrastructure"
+
+  target_criteria {
+    port     = 22
+    protocol = "SSH"
+
+    target_attributes {
+      name   = "hostname"
+      values = ["bastion"]
+    }
+  }
+}
@@ -17,3 +17,10 @@ resource "cloudflare_access_service_token" "my_app" {
     create_before_destroy = true
   }
 }
+
+# Generate a service token that is valid for 30 days
+resource "cloudflare_access_service_token" "short_lived" {
+  account_id = "d41d8cd98f00b204e9800998ecf8427e"
+  name       = "CI/CD app short lived"
+  duration   = "720h"
+}
@@ -1 +1,4 @@
 $ terraform import cloudflare_firewall_rule.example <zone_id>/<firewall_rule_id>
+
+# Alternatively, a rule can be imported using its exact description.
+$ terraform import cloudflare_firewall_rule.example "<zone_id>/description=<firewall_rule_description>"
@@ -52,3 +52,30 @@ resource "cloudflare_list" "example" {
     comment = "two"
   }
 }
+
+# Hostname list
+resource "cloudflare_list" "example" {
+  account_id  = "919f297a62fdfb28844177128ed4d331"
+  name        = "example_list"
+  description = "example hostnames for a list"
+  kind        = "hostname"
+
+  item {
+    value {
+      hostname {
+        url_hostname               = "*.example.com"
+        exclude_exploded_hostnames = true
+      }
+    }
+    comment = "subdomains of example.com only"
+  }
+
+  item {
+    value {
+      hostname {
+        url_hostname = "example.net"
+      }
+    }
+    comment = "two"
+  }
+}
@@ -318,3 +318,48 @@ resource "cloudflare_ruleset" "redirect_from_list_example" {
     enabled     = true
   }
 }
+
+# Compress responses for static assets using brotli
+resource "cloudflare_ruleset" "compression_example" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  name        = "compression"
+  description = "Compression ruleset"
+  kind        = "zone"
+  phase       = "http_response_compression"
+
+  rules {
+    action = "compress_response"
+    action_parameters {
+      algorithms {
+        name = "brotli"
+      }
+      algorithms {
+        name = "auto"
+      }
+    }
+    expression  = "starts_with(http.request.uri.path, \"/assets/\")"
+    description = "Prefer brotli for assets"
+    enabled     = true
+  }
+}
+
+# Serve a custom page for 403 errors
+resource "cloudflare_ruleset" "custom_errors_example" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  name        = "custom errors"
+  description = "Custom error responses ruleset"
+  kind        = "zone"
+  phase       = "http_custom_errors"
+
+  rules {
+    action = "serve_error"
+    action_parameters {
+      content      = "<html><body><h1>Access denied</h1></body></html>"
+      content_type = "text/html"
+      status_code  = 403
+    }
+    expression  = "http.response.code eq 403"
+    description = "Serve a custom 403 page"
+    enabled     = true
+  }
+}
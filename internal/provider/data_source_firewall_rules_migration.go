@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"sort"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// firewallRuleActionPrecedence is the order in which firewall rules without a
+// priority are evaluated, based on their action.
+var firewallRuleActionPrecedence = []string{"log", "bypass", "allow", "managed_challenge", "js_challenge", "challenge", "block"}
+
+// firewallRuleAllowSkipPhases are the phases a firewall rule with the `allow`
+// action stops from running, in addition to the remaining custom rules.
+var firewallRuleAllowSkipPhases = []string{"http_ratelimit", "http_request_firewall_managed", "http_request_sbfm"}
+
+func dataSourceCloudflareFirewallRulesMigration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareFirewallRulesMigrationRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Description: "The zone identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"phase": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"rules": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"firewall_rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"filter_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ref": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expression": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"action_parameters": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ruleset": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"phases": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"products": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareFirewallRulesMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Firewall Rules for migration in zone %q", zoneID))
+
+	var firewallRules []cloudflare.FirewallRule
+	pageOpts := cloudflare.PaginationOptions{Page: 1, PerPage: 100}
+	for {
+		rules, err := client.FirewallRules(ctx, zoneID, pageOpts)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error listing Firewall Rules for zone %q: %w", zoneID, err))
+		}
+
+		firewallRules = append(firewallRules, rules...)
+
+		if len(rules) < pageOpts.PerPage {
+			break
+		}
+		pageOpts.Page++
+	}
+
+	ruleIDs := make([]string, 0, len(firewallRules))
+	rules := make([]interface{}, 0, len(firewallRules))
+	for _, firewallRule := range sortFirewallRulesByEvaluationOrder(firewallRules) {
+		rule, err := firewallRuleToRulesetRule(firewallRule)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		rules = append(rules, rule)
+		ruleIDs = append(ruleIDs, firewallRule.ID)
+	}
+
+	d.Set("kind", string(cloudflare.RulesetKindZone))
+	d.Set("phase", string(cloudflare.RulesetPhaseHTTPRequestFirewallCustom))
+
+	if err := d.Set("rules", rules); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting migrated Firewall Rules: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append([]string{zoneID}, ruleIDs...)))
+	return nil
+}
+
+// firewallRuleToRulesetRule translates a firewall rule and its filter into the
+// equivalent `http_request_firewall_custom` ruleset rule.
+func firewallRuleToRulesetRule(firewallRule cloudflare.FirewallRule) (map[string]interface{}, error) {
+	rule := map[string]interface{}{
+		"firewall_rule_id": firewallRule.ID,
+		"filter_id":        firewallRule.Filter.ID,
+		"ref":              firewallRule.ID,
+		"expression":       html.UnescapeString(firewallRule.Filter.Expression),
+		"description":      html.UnescapeString(firewallRule.Description),
+		"enabled":          !firewallRule.Paused && !firewallRule.Filter.Paused,
+	}
+
+	switch firewallRule.Action {
+	case "block", "challenge", "js_challenge", "managed_challenge", "log":
+		rule["action"] = firewallRule.Action
+	case "allow":
+		rule["action"] = "skip"
+		rule["action_parameters"] = []interface{}{map[string]interface{}{
+			"ruleset": "current",
+			"phases":  firewallRuleAllowSkipPhases,
+		}}
+	case "bypass":
+		if len(firewallRule.Products) == 0 {
+			return nil, fmt.Errorf("firewall rule %q uses the bypass action without any products", firewallRule.ID)
+		}
+		rule["action"] = "skip"
+		rule["action_parameters"] = []interface{}{map[string]interface{}{
+			"products": firewallRule.Products,
+		}}
+	default:
+		return nil, fmt.Errorf("firewall rule %q uses action %q which has no ruleset equivalent", firewallRule.ID, firewallRule.Action)
+	}
+
+	return rule, nil
+}
+
+// sortFirewallRulesByEvaluationOrder returns the firewall rules in the order
+// they are evaluated: rules with a priority first, lowest priority wins,
+// followed by the remaining rules ordered by action precedence.
+func sortFirewallRulesByEvaluationOrder(rules []cloudflare.FirewallRule) []cloudflare.FirewallRule {
+	sorted := make([]cloudflare.FirewallRule, len(rules))
+	copy(sorted, rules)
+
+	actionPosition := make(map[string]int, len(firewallRuleActionPrecedence))
+	for i, action := range firewallRuleActionPrecedence {
+		actionPosition[action] = i
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		priorityI, okI := firewallRulePriority(sorted[i])
+		priorityJ, okJ := firewallRulePriority(sorted[j])
+
+		switch {
+		case okI && okJ:
+			return priorityI < priorityJ
+		case okI != okJ:
+			return okI
+		default:
+			return actionPosition[sorted[i].Action] < actionPosition[sorted[j].Action]
+		}
+	})
+
+	return sorted
+}
+
+// firewallRulePriority returns the priority of a firewall rule and whether it
+// has one set.
+func firewallRulePriority(rule cloudflare.FirewallRule) (float64, bool) {
+	switch priority := rule.Priority.(type) {
+	case float64:
+		return priority, true
+	case int:
+		return float64(priority), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareFirewallRulesMigration_BlockRule(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "data.cloudflare_firewall_rules_migration." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	filterQuoted := `(http.host eq \"` + domain + `\" and http.request.uri.path contains \"/` + rnd + `\")`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareFirewallRulesMigrationConfig(rnd, zoneID, filterQuoted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "kind", "zone"),
+					resource.TestCheckResourceAttr(name, "phase", "http_request_firewall_custom"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "rules.*", map[string]string{
+						"action":      "block",
+						"description": rnd + " migration",
+						"enabled":     "true",
+						"expression":  fmt.Sprintf(`(http.host eq "%s" and http.request.uri.path contains "/%s")`, domain, rnd),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareFirewallRulesMigrationConfig(resourceID, zoneID, expression string) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_filter" "%[1]s" {
+		  zone_id = "%[2]s"
+		  description = "%[1]s migration"
+		  expression = "%[3]s"
+		}
+
+		resource "cloudflare_firewall_rule" "%[1]s" {
+		  zone_id = "%[2]s"
+		  description = "%[1]s migration"
+		  filter_id = cloudflare_filter.%[1]s.id
+		  action = "block"
+		}
+
+		data "cloudflare_firewall_rules_migration" "%[1]s" {
+		  zone_id = "%[2]s"
+
+		  depends_on = [cloudflare_firewall_rule.%[1]s]
+		}
+		`, resourceID, zoneID, expression)
+}
+
+func TestFirewallRuleToRulesetRule(t *testing.T) {
+	testCases := map[string]struct {
+		firewallRule cloudflare.FirewallRule
+		expected     map[string]interface{}
+	}{
+		"block rule keeps its action and expression": {
+			firewallRule: cloudflare.FirewallRule{
+				ID:          "372e67954025e0ba6aaa6d586b9e0b59",
+				Description: "block bots &amp; scrapers",
+				Action:      "block",
+				Filter: cloudflare.Filter{
+					ID:         "372e67954025e0ba6aaa6d586b9e0b61",
+					Expression: "(cf.client.bot and http.request.uri.path contains \"/api\")",
+				},
+			},
+			expected: map[string]interface{}{
+				"firewall_rule_id": "372e67954025e0ba6aaa6d586b9e0b59",
+				"filter_id":        "372e67954025e0ba6aaa6d586b9e0b61",
+				"ref":              "372e67954025e0ba6aaa6d586b9e0b59",
+				"action":           "block",
+				"expression":       "(cf.client.bot and http.request.uri.path contains \"/api\")",
+				"description":      "block bots & scrapers",
+				"enabled":          true,
+			},
+		},
+		"paused filter disables the rule": {
+			firewallRule: cloudflare.FirewallRule{
+				ID:     "372e67954025e0ba6aaa6d586b9e0b59",
+				Action: "challenge",
+				Filter: cloudflare.Filter{
+					ID:         "372e67954025e0ba6aaa6d586b9e0b61",
+					Expression: "(ip.geoip.country eq \"T1\")",
+					Paused:     true,
+				},
+			},
+			expected: map[string]interface{}{
+				"firewall_rule_id": "372e67954025e0ba6aaa6d586b9e0b59",
+				"filter_id":        "372e67954025e0ba6aaa6d586b9e0b61",
+				"ref":              "372e67954025e0ba6aaa6d586b9e0b59",
+				"action":           "challenge",
+				"expression":       "(ip.geoip.country eq \"T1\")",
+				"description":      "",
+				"enabled":          false,
+			},
+		},
+		"allow rule skips the remaining rules and phases": {
+			firewallRule: cloudflare.FirewallRule{
+				ID:     "372e67954025e0ba6aaa6d586b9e0b59",
+				Action: "allow",
+				Filter: cloudflare.Filter{
+					ID:         "372e67954025e0ba6aaa6d586b9e0b61",
+					Expression: "(ip.src eq 192.0.2.1)",
+				},
+			},
+			expected: map[string]interface{}{
+				"firewall_rule_id": "372e67954025e0ba6aaa6d586b9e0b59",
+				"filter_id":        "372e67954025e0ba6aaa6d586b9e0b61",
+				"ref":              "372e67954025e0ba6aaa6d586b9e0b59",
+				"action":           "skip",
+				"expression":       "(ip.src eq 192.0.2.1)",
+				"description":      "",
+				"enabled":          true,
+				"action_parameters": []interface{}{map[string]interface{}{
+					"ruleset": "current",
+					"phases":  []string{"http_ratelimit", "http_request_firewall_managed", "http_request_sbfm"},
+				}},
+			},
+		},
+		"bypass rule skips the listed products": {
+			firewallRule: cloudflare.FirewallRule{
+				ID:       "372e67954025e0ba6aaa6d586b9e0b59",
+				Action:   "bypass",
+				Products: []string{"zoneLockdown", "uaBlock"},
+				Filter: cloudflare.Filter{
+					ID:         "372e67954025e0ba6aaa6d586b9e0b61",
+					Expression: "(ip.src eq 192.0.2.1)",
+				},
+			},
+			expected: map[string]interface{}{
+				"firewall_rule_id": "372e67954025e0ba6aaa6d586b9e0b59",
+				"filter_id":        "372e67954025e0ba6aaa6d586b9e0b61",
+				"ref":              "372e67954025e0ba6aaa6d586b9e0b59",
+				"action":           "skip",
+				"expression":       "(ip.src eq 192.0.2.1)",
+				"description":      "",
+				"enabled":          true,
+				"action_parameters": []interface{}{map[string]interface{}{
+					"products": []string{"zoneLockdown", "uaBlock"},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := firewallRuleToRulesetRule(tc.firewallRule)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %#v, got %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSortFirewallRulesByEvaluationOrder(t *testing.T) {
+	rules := []cloudflare.FirewallRule{
+		{ID: "block", Action: "block"},
+		{ID: "priority-10", Action: "log", Priority: float64(10)},
+		{ID: "allow", Action: "allow"},
+		{ID: "priority-1", Action: "block", Priority: float64(1)},
+		{ID: "log", Action: "log"},
+	}
+
+	var got []string
+	for _, rule := range sortFirewallRulesByEvaluationOrder(rules) {
+		got = append(got, rule.ID)
+	}
+
+	expected := []string{"priority-1", "priority-10", "log", "allow", "block"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected rules in order %v, got %v", expected, got)
+	}
+}
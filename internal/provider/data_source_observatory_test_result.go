@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	observatoryReportStateRunning = "RUNNING"
+	observatoryReportStateFailed  = "FAILED"
+)
+
+// observatoryRegions are the regions an Observatory test can be run from.
+var observatoryRegions = []string{
+	"asia-east1", "asia-northeast1", "asia-northeast2", "asia-south1",
+	"asia-southeast1", "australia-southeast1", "europe-north1",
+	"europe-southwest1", "europe-west1", "europe-west2", "europe-west3",
+	"europe-west4", "europe-west8", "europe-west9", "me-west1",
+	"southamerica-east1", "us-central1", "us-east1", "us-east4", "us-south1",
+	"us-west1",
+}
+
+type observatoryTestRegion struct {
+	Value string `json:"value"`
+	Label string `json:"label,omitempty"`
+}
+
+type observatoryReportFailure struct {
+	Code   string `json:"code"`
+	Detail string `json:"detail"`
+}
+
+type observatoryReport struct {
+	State            string                    `json:"state"`
+	PerformanceScore int                       `json:"performanceScore"`
+	FCP              float64                   `json:"fcp"`
+	LCP              float64                   `json:"lcp"`
+	SI               float64                   `json:"si"`
+	TBT              float64                   `json:"tbt"`
+	TTI              float64                   `json:"tti"`
+	TTFB             float64                   `json:"ttfb"`
+	CLS              float64                   `json:"cls"`
+	Error            *observatoryReportFailure `json:"error,omitempty"`
+}
+
+type observatoryTest struct {
+	ID            string                `json:"id"`
+	Date          *time.Time            `json:"date,omitempty"`
+	URL           string                `json:"url"`
+	Region        observatoryTestRegion `json:"region"`
+	MobileReport  observatoryReport     `json:"mobileReport"`
+	DesktopReport observatoryReport     `json:"desktopReport"`
+}
+
+func observatoryReportSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"performance_score": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"fcp": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"lcp": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"si": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"tbt": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"tti": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"ttfb": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+				"cls": {
+					Type:     schema.TypeFloat,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareObservatoryTestResult() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareObservatoryTestResultRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Description: "The zone identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "us-central1",
+				ValidateFunc: validation.StringInSlice(observatoryRegions, false),
+			},
+
+			"test_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mobile_report":  observatoryReportSchema(),
+			"desktop_report": observatoryReportSchema(),
+		},
+	}
+}
+
+func dataSourceCloudflareObservatoryTestResultRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	pageURL := d.Get("url").(string)
+	region := d.Get("region").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Triggering Observatory test for %q from %q in zone %q", pageURL, region, zoneID))
+
+	var test observatoryTest
+	uri := observatoryPageTestsURI(zoneID, pageURL)
+	if err := rawAPIRequest(ctx, client, http.MethodPost, uri, map[string]string{"region": region}, &test); err != nil {
+		return diag.FromErr(fmt.Errorf("error triggering Observatory test for %q in zone %q: %w", pageURL, zoneID, err))
+	}
+
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
+		if !observatoryTestRunning(test) {
+			return nil
+		}
+
+		// Raw requests don't take a context, so stop polling as soon as the
+		// read is cancelled rather than issuing another request.
+		if err := ctx.Err(); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error waiting for Observatory test %q in zone %q: %w", test.ID, zoneID, err))
+		}
+
+		if err := rawAPIRequest(ctx, client, http.MethodGet, uri+"/"+test.ID, nil, &test); err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error reading Observatory test %q in zone %q: %w", test.ID, zoneID, err))
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Observatory test %s mobile report: %s, desktop report: %s", test.ID, test.MobileReport.State, test.DesktopReport.State))
+
+		if observatoryTestRunning(test) {
+			return resource.RetryableError(fmt.Errorf("observatory test %q is still running", test.ID))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := observatoryReportError(test.ID, "mobile", test.MobileReport); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := observatoryReportError(test.ID, "desktop", test.DesktopReport); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("test_id", test.ID)
+	if test.Date != nil {
+		d.Set("date", test.Date.Format(time.RFC3339))
+	}
+	if err := d.Set("mobile_report", flattenObservatoryReport(test.MobileReport)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting mobile_report: %w", err))
+	}
+	if err := d.Set("desktop_report", flattenObservatoryReport(test.DesktopReport)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting desktop_report: %w", err))
+	}
+
+	// every read triggers a new test, the ID stays the same so the data
+	// source can be referenced without causing changes.
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s/%s", zoneID, pageURL, region)))
+	return nil
+}
+
+func observatoryPageTestsURI(zoneID, pageURL string) string {
+	return fmt.Sprintf("/zones/%s/speed_api/pages/%s/tests", zoneID, url.PathEscape(pageURL))
+}
+
+// observatoryTestRunning returns whether either report of the test hasn't
+// finished yet.
+func observatoryTestRunning(test observatoryTest) bool {
+	return test.MobileReport.State == observatoryReportStateRunning || test.DesktopReport.State == observatoryReportStateRunning
+}
+
+// observatoryReportError returns an error describing why a report failed, if
+// it did.
+func observatoryReportError(testID, device string, report observatoryReport) error {
+	if report.State != observatoryReportStateFailed {
+		return nil
+	}
+
+	detail := "no details returned"
+	if report.Error != nil {
+		detail = fmt.Sprintf("%s: %s", report.Error.Code, report.Error.Detail)
+	}
+
+	return fmt.Errorf("observatory test %q %s report failed (%s)", testID, device, detail)
+}
+
+func flattenObservatoryReport(report observatoryReport) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"performance_score": report.PerformanceScore,
+		"fcp":               report.FCP,
+		"lcp":               report.LCP,
+		"si":                report.SI,
+		"tbt":               report.TBT,
+		"tti":               report.TTI,
+		"ttfb":              report.TTFB,
+		"cls":               report.CLS,
+	}}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareObservatoryTestResult(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "data.cloudflare_observatory_test_result." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareObservatoryTestResultConfig(rnd, zoneID, domain+"/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "region", "us-central1"),
+					resource.TestCheckResourceAttrSet(name, "test_id"),
+					resource.TestCheckResourceAttrSet(name, "mobile_report.0.performance_score"),
+					resource.TestCheckResourceAttrSet(name, "desktop_report.0.lcp"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareObservatoryTestResultConfig(name, zoneID, url string) string {
+	return fmt.Sprintf(`
+data "cloudflare_observatory_test_result" "%[1]s" {
+  zone_id = "%[2]s"
+  url     = "%[3]s"
+}`, name, zoneID, url)
+}
+
+func TestObservatoryTestResultReadWaitsForScores(t *testing.T) {
+	mux := http.NewServeMux()
+	testsURI := "/zones/" + testAccCloudflareZoneID + "/speed_api/pages/example.com/blog/tests"
+	runningTest := `{
+		"id": "8c4a3f1e-3a6a-4e51-9b5d-0a6c1d2e3f4a",
+		"date": "2026-10-16T10:00:00Z",
+		"url": "example.com/blog",
+		"region": {"value": "europe-west1", "label": "Belgium"},
+		"mobileReport": {"state": "RUNNING"},
+		"desktopReport": {"state": "RUNNING"}
+	}`
+	completeTest := `{
+		"id": "8c4a3f1e-3a6a-4e51-9b5d-0a6c1d2e3f4a",
+		"date": "2026-10-16T10:00:00Z",
+		"url": "example.com/blog",
+		"region": {"value": "europe-west1", "label": "Belgium"},
+		"mobileReport": {"state": "COMPLETE", "performanceScore": 87, "fcp": 1200.5, "lcp": 2100, "si": 1800, "tbt": 150, "tti": 3000, "ttfb": 320, "cls": 0.05},
+		"desktopReport": {"state": "COMPLETE", "performanceScore": 98, "fcp": 400, "lcp": 650, "si": 700, "tbt": 10, "tti": 900, "ttfb": 120, "cls": 0.01}
+	}`
+
+	mux.HandleFunc(testsURI, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected a POST request to trigger the test, got %s", r.Method)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, runningTest)
+	})
+	mux.HandleFunc(testsURI+"/8c4a3f1e-3a6a-4e51-9b5d-0a6c1d2e3f4a", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, completeTest)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareObservatoryTestResult().Schema, map[string]interface{}{
+		"zone_id": testAccCloudflareZoneID,
+		"url":     "example.com/blog",
+		"region":  "europe-west1",
+	})
+
+	if diags := dataSourceCloudflareObservatoryTestResultRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := map[string]string{
+		"test_id":                            "8c4a3f1e-3a6a-4e51-9b5d-0a6c1d2e3f4a",
+		"date":                               "2026-10-16T10:00:00Z",
+		"mobile_report.0.performance_score":  "87",
+		"mobile_report.0.fcp":                "1200.5",
+		"mobile_report.0.lcp":                "2100",
+		"mobile_report.0.ttfb":               "320",
+		"mobile_report.0.cls":                "0.05",
+		"desktop_report.0.performance_score": "98",
+		"desktop_report.0.lcp":               "650",
+		"desktop_report.0.ttfb":              "120",
+	}
+	for key, value := range expected {
+		if got := fmt.Sprintf("%v", d.Get(key)); got != value {
+			t.Errorf("expected %s to be %q, got %q", key, value, got)
+		}
+	}
+
+	// the ID only depends on the inputs so repeated reads don't cause changes.
+	firstID := d.Id()
+	if diags := dataSourceCloudflareObservatoryTestResultRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Id() != firstID {
+		t.Errorf("expected ID to stay %q, got %q", firstID, d.Id())
+	}
+}
+
+func TestObservatoryTestResultReadStopsPollingWhenCancelled(t *testing.T) {
+	mux := http.NewServeMux()
+	testsURI := "/zones/" + testAccCloudflareZoneID + "/speed_api/pages/example.com/blog/tests"
+	mux.HandleFunc(testsURI, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "8c4a3f1e-3a6a-4e51-9b5d-0a6c1d2e3f4a", "mobileReport": {"state": "RUNNING"}, "desktopReport": {"state": "RUNNING"}}}`)
+	})
+	mux.HandleFunc(testsURI+"/8c4a3f1e-3a6a-4e51-9b5d-0a6c1d2e3f4a", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request polling a cancelled Observatory test", r.Method)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareObservatoryTestResult().Schema, map[string]interface{}{
+		"zone_id": testAccCloudflareZoneID,
+		"url":     "example.com/blog",
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if diags := dataSourceCloudflareObservatoryTestResultRead(ctx, d, client); !diags.HasError() {
+		t.Fatal("expected an error reading with a cancelled context")
+	}
+}
+
+func TestObservatoryTestResultReadFailedReport(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/speed_api/pages/example.com/blog/tests", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "8c4a3f1e-3a6a-4e51-9b5d-0a6c1d2e3f4a",
+				"mobileReport": {"state": "COMPLETE", "performanceScore": 87},
+				"desktopReport": {"state": "FAILED", "error": {"code": "NOT_REACHABLE", "detail": "The page could not be loaded"}}
+			}
+		}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareObservatoryTestResult().Schema, map[string]interface{}{
+		"zone_id": testAccCloudflareZoneID,
+		"url":     "example.com/blog",
+	})
+
+	diags := dataSourceCloudflareObservatoryTestResultRead(context.Background(), d, client)
+	if !diags.HasError() {
+		t.Fatal("expected an error for a failed report")
+	}
+
+	expected := "desktop report failed (NOT_REACHABLE: The page could not be loaded)"
+	if !strings.Contains(diags[0].Summary, expected) {
+		t.Errorf("expected error containing %q, got %q", expected, diags[0].Summary)
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tunnelDetails extends the Cloudflare Tunnel of cloudflare-go with the
+// health status reported by the API.
+type tunnelDetails struct {
+	cloudflare.Tunnel
+	Status string `json:"status"`
+}
+
+func dataSourceCloudflareTunnelStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareTunnelStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "The account identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"tunnel_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"active_connectors": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"conns_active_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"colo_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"origin_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"opened_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_pending_reconnect": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCloudflareTunnelStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	tunnelID := d.Get("tunnel_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading status of Cloudflare Tunnel %q in account %q", tunnelID, accountID))
+
+	var tunnel tunnelDetails
+	if err := rawAPIRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", accountID, tunnelID), nil, &tunnel); err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return diag.FromErr(fmt.Errorf("cloudflare Tunnel %q not found in account %q", tunnelID, accountID))
+		}
+		return diag.FromErr(fmt.Errorf("error reading Cloudflare Tunnel %q: %w", tunnelID, err))
+	}
+
+	d.Set("name", tunnel.Name)
+	d.Set("status", tunnel.Status)
+	d.Set("active_connectors", countActiveTunnelConnectors(tunnel.Connections))
+	if tunnel.ConnsActiveAt != nil {
+		d.Set("conns_active_at", tunnel.ConnsActiveAt.Format(time.RFC3339))
+	} else {
+		d.Set("conns_active_at", "")
+	}
+
+	connections := make([]map[string]interface{}, 0, len(tunnel.Connections))
+	for _, connection := range tunnel.Connections {
+		connections = append(connections, map[string]interface{}{
+			"id":                   connection.ID,
+			"client_id":            connection.ClientID,
+			"client_version":       connection.ClientVersion,
+			"colo_name":            connection.ColoName,
+			"origin_ip":            connection.OriginIP,
+			"opened_at":            connection.OpenedAt,
+			"is_pending_reconnect": connection.IsPendingReconnect,
+		})
+	}
+	if err := d.Set("connections", connections); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Cloudflare Tunnel connections: %w", err))
+	}
+
+	d.SetId(tunnel.ID)
+	return nil
+}
+
+// countActiveTunnelConnectors returns the number of connectors, cloudflared
+// instances, with at least one connection that isn't waiting to reconnect.
+func countActiveTunnelConnectors(connections []cloudflare.TunnelConnection) int {
+	connectors := make(map[string]bool)
+	for _, connection := range connections {
+		if !connection.IsPendingReconnect {
+			connectors[connection.ClientID] = true
+		}
+	}
+
+	return len(connectors)
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareTunnelStatus(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Argo Tunnel
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := "data.cloudflare_tunnel_status." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTunnelStatusConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "tunnel_id", "cloudflare_argo_tunnel."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "status", "inactive"),
+					resource.TestCheckResourceAttr(name, "active_connectors", "0"),
+					resource.TestCheckResourceAttr(name, "connections.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTunnelStatusConfig(name, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_argo_tunnel" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+}
+
+data "cloudflare_tunnel_status" "%[1]s" {
+  account_id = "%[2]s"
+  tunnel_id  = cloudflare_argo_tunnel.%[1]s.id
+}`, name, accountID)
+}
+
+func TestTunnelStatusReadHealthyTunnel(t *testing.T) {
+	mux := http.NewServeMux()
+	tunnelID := "f70ff985-a4ef-4643-bbbc-4a0ed4fc8415"
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/cfd_tunnel/"+tunnelID, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "%s",
+				"name": "blog",
+				"status": "healthy",
+				"created_at": "2026-01-01T10:00:00Z",
+				"conns_active_at": "2026-10-16T09:00:00Z",
+				"connections": [
+					{"id": "1bedc50d-42b3-473c-b108-ff3d10c0d925", "client_id": "dc6472cc-f1ae-44a0-b795-6b8a0ce29f90", "client_version": "2026.9.0", "colo_name": "DFW", "origin_ip": "192.0.2.10", "opened_at": "2026-10-16T09:00:00Z", "is_pending_reconnect": false},
+					{"id": "2bedc50d-42b3-473c-b108-ff3d10c0d925", "client_id": "dc6472cc-f1ae-44a0-b795-6b8a0ce29f90", "client_version": "2026.9.0", "colo_name": "ORD", "origin_ip": "192.0.2.10", "opened_at": "2026-10-16T09:00:00Z", "is_pending_reconnect": false},
+					{"id": "3bedc50d-42b3-473c-b108-ff3d10c0d925", "client_id": "ec6472cc-f1ae-44a0-b795-6b8a0ce29f90", "client_version": "2026.9.0", "colo_name": "DFW", "origin_ip": "192.0.2.11", "opened_at": "2026-10-16T09:00:00Z", "is_pending_reconnect": false},
+					{"id": "4bedc50d-42b3-473c-b108-ff3d10c0d925", "client_id": "fc6472cc-f1ae-44a0-b795-6b8a0ce29f90", "client_version": "2026.8.0", "colo_name": "ORD", "origin_ip": "192.0.2.12", "opened_at": "2026-10-15T09:00:00Z", "is_pending_reconnect": true}
+				]
+			}
+		}`, tunnelID)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareTunnelStatus().Schema, map[string]interface{}{
+		"account_id": testAccCloudflareAccountID,
+		"tunnel_id":  tunnelID,
+	})
+
+	if diags := dataSourceCloudflareTunnelStatusRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != tunnelID {
+		t.Errorf("expected ID to be the tunnel ID %q, got %q", tunnelID, d.Id())
+	}
+
+	expected := map[string]interface{}{
+		"name":                               "blog",
+		"status":                             "healthy",
+		"active_connectors":                  2,
+		"conns_active_at":                    "2026-10-16T09:00:00Z",
+		"connections.#":                      4,
+		"connections.0.colo_name":            "DFW",
+		"connections.3.client_version":       "2026.8.0",
+		"connections.3.is_pending_reconnect": true,
+	}
+	for key, value := range expected {
+		if got := d.Get(key); got != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, got)
+		}
+	}
+}
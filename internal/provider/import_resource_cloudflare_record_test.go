@@ -30,3 +30,27 @@ func TestAccCloudflareRecord_Import(t *testing.T) {
 		},
 	})
 }
+
+func TestAccCloudflareRecord_ImportByNameAndType(t *testing.T) {
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigBasic(zoneID, rnd, rnd),
+			},
+			{
+				ResourceName:            name,
+				ImportStateId:           fmt.Sprintf("%s/%s.%s/A", zoneID, rnd, zoneName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_overwrite"},
+			},
+		},
+	})
+}
@@ -143,6 +143,21 @@ func New(version string) func() *schema.Provider {
 					DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_API_BASE_PATH", "/client/v4"),
 					Description: "Configure the base path used by the API client. Alternatively, can be configured using the `CLOUDFLARE_API_BASE_PATH` environment variable.",
 				},
+
+				"api_base_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					DefaultFunc:  schema.EnvDefaultFunc("CLOUDFLARE_API_BASE_URL", nil),
+					Description:  "Configure the full base URL used by the API client, such as a local mock of the Cloudflare API. Takes precedence over `api_hostname` and `api_base_path` when set and must use https unless the host is localhost. Alternatively, can be configured using the `CLOUDFLARE_API_BASE_URL` environment variable.",
+					ValidateFunc: validateAPIBaseURL,
+				},
+
+				"verify_token": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("CLOUDFLARE_VERIFY_TOKEN", false),
+					Description: "Whether to verify the API token with Cloudflare when the provider is configured, failing early if it is invalid, expired or not yet active. Requires `api_token`. Alternatively, can be configured using the `CLOUDFLARE_VERIFY_TOKEN` environment variable.",
+				},
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
@@ -150,8 +165,11 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_account_roles":               dataSourceCloudflareAccountRoles(),
 				"cloudflare_api_token_permission_groups": dataSourceCloudflareApiTokenPermissionGroups(),
 				"cloudflare_devices":                     dataSourceCloudflareDevices(),
+				"cloudflare_firewall_rules_migration":    dataSourceCloudflareFirewallRulesMigration(),
 				"cloudflare_ip_ranges":                   dataSourceCloudflareIPRanges(),
+				"cloudflare_observatory_test_result":     dataSourceCloudflareObservatoryTestResult(),
 				"cloudflare_origin_ca_root_certificate":  dataSourceCloudflareOriginCARootCertificate(),
+				"cloudflare_tunnel_status":               dataSourceCloudflareTunnelStatus(),
 				"cloudflare_waf_groups":                  dataSourceCloudflareWAFGroups(),
 				"cloudflare_waf_packages":                dataSourceCloudflareWAFPackages(),
 				"cloudflare_waf_rules":                   dataSourceCloudflareWAFRules(),
@@ -170,6 +188,7 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_access_policy":                          resourceCloudflareAccessPolicy(),
 				"cloudflare_access_rule":                            resourceCloudflareAccessRule(),
 				"cloudflare_access_service_token":                   resourceCloudflareAccessServiceToken(),
+				"cloudflare_access_user_revocation":                 resourceCloudflareAccessUserRevocation(),
 				"cloudflare_access_bookmark":                        resourceCloudflareAccessBookmark(),
 				"cloudflare_account_member":                         resourceCloudflareAccountMember(),
 				"cloudflare_api_token":                              resourceCloudflareApiToken(),
@@ -201,6 +220,7 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_logpush_job":                            resourceCloudflareLogpushJob(),
 				"cloudflare_logpush_ownership_challenge":            resourceCloudflareLogpushOwnershipChallenge(),
 				"cloudflare_magic_firewall_ruleset":                 resourceCloudflareMagicFirewallRuleset(),
+				"cloudflare_magic_transit_site_acl":                 resourceCloudflareMagicTransitSiteACL(),
 				"cloudflare_managed_headers":                        resourceCloudflareManagedHeaders(),
 				"cloudflare_notification_policy_webhooks":           resourceCloudflareNotificationPolicyWebhooks(),
 				"cloudflare_notification_policy":                    resourceCloudflareNotificationPolicy(),
@@ -208,6 +228,7 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_page_rule":                              resourceCloudflarePageRule(),
 				"cloudflare_rate_limit":                             resourceCloudflareRateLimit(),
 				"cloudflare_record":                                 resourceCloudflareRecord(),
+				"cloudflare_registrar_domain":                       resourceCloudflareRegistrarDomain(),
 				"cloudflare_ruleset":                                resourceCloudflareRuleset(),
 				"cloudflare_spectrum_application":                   resourceCloudflareSpectrumApplication(),
 				"cloudflare_split_tunnel":                           resourceCloudflareSplitTunnel(),
@@ -230,9 +251,14 @@ func New(version string) func() *schema.Provider {
 				"cloudflare_worker_script":                          resourceCloudflareWorkerScript(),
 				"cloudflare_workers_kv_namespace":                   resourceCloudflareWorkersKVNamespace(),
 				"cloudflare_workers_kv":                             resourceCloudflareWorkerKV(),
+				"cloudflare_zero_trust_device_default_profile":      resourceCloudflareZeroTrustDeviceDefaultProfile(),
+				"cloudflare_zero_trust_device_managed_networks":     resourceCloudflareZeroTrustDeviceManagedNetworks(),
+				"cloudflare_zero_trust_gateway_certificate":         resourceCloudflareZeroTrustGatewayCertificate(),
+				"cloudflare_zero_trust_risk_behavior":               resourceCloudflareZeroTrustRiskBehavior(),
 				"cloudflare_zone_cache_variants":                    resourceCloudflareZoneCacheVariants(),
 				"cloudflare_zone_dnssec":                            resourceCloudflareZoneDNSSEC(),
 				"cloudflare_zone_lockdown":                          resourceCloudflareZoneLockdown(),
+				"cloudflare_zone_setting":                           resourceCloudflareZoneSetting(),
 				"cloudflare_zone_settings_override":                 resourceCloudflareZoneSettingsOverride(),
 				"cloudflare_zone":                                   resourceCloudflareZone(),
 			},
@@ -252,6 +278,9 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		baseURL := cloudflare.BaseURL(
 			"https://" + d.Get("api_hostname").(string) + d.Get("api_base_path").(string),
 		)
+		if v, ok := d.GetOk("api_base_url"); ok {
+			baseURL = cloudflare.BaseURL(strings.TrimSuffix(v.(string), "/"))
+		}
 		limitOpt := cloudflare.UsingRateLimit(float64(d.Get("rps").(int)))
 		retryOpt := cloudflare.UsingRetryPolicy(d.Get("retries").(int), d.Get("min_backoff").(int), d.Get("max_backoff").(int))
 		options := []cloudflare.Option{limitOpt, retryOpt, baseURL}
@@ -261,7 +290,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		}
 
 		c := cleanhttp.DefaultClient()
-		c.Transport = logging.NewTransport("Cloudflare", c.Transport)
+		c.Transport = rawResponseTransport{next: logging.NewTransport("Cloudflare", c.Transport)}
 		options = append(options, cloudflare.HTTPClient(c))
 
 		ua := fmt.Sprintf("terraform/%s terraform-plugin-sdk/%s terraform-provider-cloudflare/%s", p.TerraformVersion, meta.SDKVersionString(), version)
@@ -300,6 +329,12 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			return nil, diag.FromErr(err)
 		}
 
+		if d.Get("verify_token").(bool) {
+			if diags := verifyAPIToken(ctx, client, config.APIToken); diags.HasError() {
+				return nil, diags
+			}
+		}
+
 		if accountID, ok := d.GetOk("account_id"); ok {
 			tflog.Info(ctx, fmt.Sprintf("using specified account id %s in Cloudflare provider", accountID.(string)))
 			options = append(options, cloudflare.UsingAccount(accountID.(string)))
@@ -317,3 +352,37 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		return client, nil
 	}
 }
+
+// verifyAPIToken checks that the configured API token is known to Cloudflare
+// and currently active so that credential problems surface at configure time
+// instead of part way through an apply.
+func verifyAPIToken(ctx context.Context, client *cloudflare.API, apiToken string) diag.Diagnostics {
+	if apiToken == "" {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "verify_token requires api_token to be set",
+			Detail:   "Token verification is only available when authenticating with an API token. Either configure `api_token` or disable `verify_token`.",
+		}}
+	}
+
+	token, err := client.VerifyAPIToken(ctx)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "failed to verify API token",
+			Detail:   fmt.Sprintf("The API token could not be verified, it may be invalid or revoked: %s", err),
+		}}
+	}
+
+	if token.Status != "active" {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "API token is not active",
+			Detail:   fmt.Sprintf("The API token %s has status %q. Expired, disabled or not yet valid tokens cannot be used.", token.ID, token.Status),
+		}}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("verified API token %s is active", token.ID))
+
+	return nil
+}
@@ -2,9 +2,14 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -62,6 +67,97 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderConfigureAPIBaseURL(t *testing.T) {
+	t.Setenv("CLOUDFLARE_API_KEY", "")
+	t.Setenv("CLOUDFLARE_EMAIL", "")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/client/v4/zones/"+testAccCloudflareZoneID+"/firewall/rules/f2d427378e7542acb295380d352e2ebd", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "f2d427378e7542acb295380d352e2ebd", "paused": false, "description": "mocked", "action": "block", "priority": 1, "filter": {"id": "372e67954025e0ba6aaa6d586b9e0b61"}}}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	p := New("dev")()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"api_token":    "abcdef1234567890abcdef1234567890abcdef12",
+		"api_base_url": server.URL + "/client/v4/",
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected error configuring provider: %v", diags)
+	}
+
+	client := p.Meta().(*cloudflare.API)
+	if client.BaseURL != server.URL+"/client/v4" {
+		t.Fatalf("expected base URL %q, got %q", server.URL+"/client/v4", client.BaseURL)
+	}
+
+	d := resourceCloudflareFirewallRule().TestResourceData()
+	d.SetId("f2d427378e7542acb295380d352e2ebd")
+	d.Set("zone_id", testAccCloudflareZoneID)
+
+	if diags := resourceCloudflareFirewallRuleRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error reading firewall rule: %v", diags)
+	}
+
+	if got := d.Get("description").(string); got != "mocked" {
+		t.Fatalf("expected firewall rule to be read from the mock, got description %q", got)
+	}
+}
+
+func TestProviderConfigureVerifyToken(t *testing.T) {
+	t.Setenv("CLOUDFLARE_API_KEY", "")
+	t.Setenv("CLOUDFLARE_EMAIL", "")
+
+	testCases := map[string]struct {
+		status      int
+		body        string
+		expectError bool
+	}{
+		"active token": {
+			status: http.StatusOK,
+			body:   `{"success": true, "errors": [], "messages": [], "result": {"id": "ed17574386854bf78a67040be0a770b0", "status": "active"}}`,
+		},
+		"expired token": {
+			status:      http.StatusOK,
+			body:        `{"success": true, "errors": [], "messages": [], "result": {"id": "ed17574386854bf78a67040be0a770b0", "status": "expired"}}`,
+			expectError: true,
+		},
+		"invalid token": {
+			status:      http.StatusUnauthorized,
+			body:        `{"success": false, "errors": [{"code": 1000, "message": "Invalid API Token"}], "messages": [], "result": null}`,
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/user/tokens/verify", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("content-type", "application/json")
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			diags := New("dev")().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+				"api_token":    "abcdef1234567890abcdef1234567890abcdef12",
+				"api_base_url": server.URL,
+				"verify_token": true,
+				"retries":      0,
+			}))
+
+			if tc.expectError != diags.HasError() {
+				t.Fatalf("expected error: %t, got diagnostics: %v", tc.expectError, diags)
+			}
+		})
+	}
+}
+
 type preCheckFunc = func(*testing.T)
 
 func testAccPreCheck(t *testing.T) {
@@ -166,6 +262,59 @@ func testAccPreCheckBYOIPPrefix(t *testing.T) {
 	}
 }
 
+func testAccPreCheckRegistrarDomain(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_REGISTRAR_DOMAIN"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_REGISTRAR_DOMAIN is not set")
+	}
+}
+
+func testAccPreCheckGatewayCustomCertificate(t *testing.T) {
+	if v := os.Getenv("CLOUDFLARE_GATEWAY_CUSTOM_CERTIFICATE_ID"); v == "" {
+		t.Skip("Skipping acceptance test as CLOUDFLARE_GATEWAY_CUSTOM_CERTIFICATE_ID is not set")
+	}
+}
+
+func testAccPreCheckMagicWANSite(t *testing.T) {
+	for _, v := range []string{"CLOUDFLARE_MAGIC_WAN_SITE_ID", "CLOUDFLARE_MAGIC_WAN_LAN_1_ID", "CLOUDFLARE_MAGIC_WAN_LAN_2_ID"} {
+		if os.Getenv(v) == "" {
+			t.Skipf("Skipping acceptance test as %s is not set", v)
+		}
+	}
+}
+
+// newTestAPIClient starts a mock API server serving mux and returns a client
+// targeting it, with the response recording transport the provider configures.
+// The server is shut down once the test completes.
+func newTestAPIClient(t *testing.T, mux *http.ServeMux, opts ...cloudflare.Option) *cloudflare.API {
+	t.Helper()
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := cloudflare.NewWithAPIToken("abcdef1234567890abcdef1234567890abcdef12", append([]cloudflare.Option{
+		cloudflare.BaseURL(server.URL),
+		cloudflare.HTTPClient(&http.Client{Transport: rawResponseTransport{next: http.DefaultTransport}}),
+	}, opts...)...)
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+
+	return client
+}
+
+// decodeTestRequestBody decodes the JSON body of a request received by a mock
+// API handler into v. Handlers don't run on the test goroutine so failures
+// are reported with t.Errorf, the handler should return when it's false.
+func decodeTestRequestBody(t *testing.T, w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		t.Errorf("error decoding %s %s request body: %s", r.Method, r.URL.Path, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	return true
+}
+
 func generateRandomResourceName() string {
 	return acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 }
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -22,6 +25,10 @@ func resourceCloudflareAccessApplication() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAccessApplicationImport,
 		},
+		CustomizeDiff: customdiff.All(
+			resourceCloudflareAccessApplicationValidateType,
+			resourceCloudflareAccessApplicationValidateTags,
+		),
 		Description: `Provides a Cloudflare Access Application resource. Access
 Applications are used to restrict access to a whole application using an
 authorisation gateway managed by Cloudflare.
@@ -29,6 +36,102 @@ authorisation gateway managed by Cloudflare.
 	}
 }
 
+// accessApplicationExtensions holds the fields of an Access Application that
+// cloudflare-go doesn't support yet. They are sent in a separate request once
+// the rest of the application has been saved.
+type accessApplicationExtensions struct {
+	Tags                     []string `json:"tags"`
+	OptionsPreflightBypass   bool     `json:"options_preflight_bypass"`
+	PathCookieAttribute      bool     `json:"path_cookie_attribute"`
+	AllowAuthenticateViaWarp *bool    `json:"allow_authenticate_via_warp,omitempty"`
+
+	TargetCriteria []accessApplicationTargetCriteria `json:"target_criteria,omitempty"`
+}
+
+// infrastructureAccessApplication is an Access Application of type
+// `infrastructure`. These can't be saved without their `target_criteria`, so
+// they are created and updated along with their extensions in one request.
+type infrastructureAccessApplication struct {
+	cloudflare.AccessApplication
+	accessApplicationExtensions
+}
+
+// accessApplicationTargetCriteria describes the infrastructure targets an
+// Access Application of type `infrastructure` secures.
+type accessApplicationTargetCriteria struct {
+	Port             int                 `json:"port"`
+	Protocol         string              `json:"protocol"`
+	TargetAttributes map[string][]string `json:"target_attributes"`
+}
+
+// resourceCloudflareAccessApplicationValidateType ensures `domain` and
+// `target_criteria` are only used with the application types that support
+// them, as infrastructure applications are addressed by their targets instead
+// of a domain.
+func resourceCloudflareAccessApplicationValidateType(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	appType := d.Get("type").(string)
+	_, hasTargetCriteria := d.GetOk("target_criteria")
+
+	if appType == "infrastructure" {
+		if !hasTargetCriteria {
+			return fmt.Errorf("target_criteria must be set for Access Applications of type %q", appType)
+		}
+		return nil
+	}
+
+	if hasTargetCriteria {
+		return fmt.Errorf("target_criteria can only be set for Access Applications of type \"infrastructure\", got %q", appType)
+	}
+
+	if _, ok := d.GetOk("domain"); !ok && d.NewValueKnown("domain") {
+		return fmt.Errorf("domain must be set for Access Applications of type %q", appType)
+	}
+
+	return nil
+}
+
+// accessApplicationsURI returns the Access Applications endpoint for the
+// account or zone the resource targets.
+func accessApplicationsURI(identifier *AccessIdentifier) string {
+	return fmt.Sprintf("/%ss/%s/access/apps", identifier.Type, identifier.Value)
+}
+
+// buildAccessApplicationExtensions returns the configured fields of the
+// application that cloudflare-go doesn't support yet.
+func buildAccessApplicationExtensions(d *schema.ResourceData) accessApplicationExtensions {
+	extensions := accessApplicationExtensions{
+		Tags:                   expandInterfaceToStringList(d.Get("tags").(*schema.Set).List()),
+		OptionsPreflightBypass: d.Get("options_preflight_bypass").(bool),
+		PathCookieAttribute:    d.Get("path_cookie_attribute").(bool),
+	}
+
+	if value, ok := d.GetOkExists("allow_authenticate_via_warp"); ok {
+		extensions.AllowAuthenticateViaWarp = cloudflare.BoolPtr(value.(bool))
+	}
+
+	if _, ok := d.GetOk("target_criteria"); ok {
+		extensions.TargetCriteria = convertTargetCriteriaSchemaToStruct(d)
+	}
+
+	return extensions
+}
+
+// isEmpty reports whether none of the extension fields are set, in which
+// case the application doesn't need a second request.
+func (e accessApplicationExtensions) isEmpty() bool {
+	return len(e.Tags) == 0 && !e.OptionsPreflightBypass && !e.PathCookieAttribute && e.AllowAuthenticateViaWarp == nil && len(e.TargetCriteria) == 0
+}
+
+// updateAccessApplicationExtensions sets the fields cloudflare-go doesn't
+// support yet on an application saved with it.
+func updateAccessApplicationExtensions(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, appID string, extensions accessApplicationExtensions) error {
+	if err := rawAPIRequest(ctx, client, http.MethodPatch, fmt.Sprintf("%s/%s", accessApplicationsURI(identifier), appID), extensions, nil); err != nil {
+		return fmt.Errorf("error updating Access Application %q: %w", appID, err)
+	}
+
+	return nil
+}
+
 func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -64,7 +167,9 @@ func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.Re
 		newAccessApplication.CorsHeaders = CORSConfig
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Access Application from struct: %+v", newAccessApplication))
+	extensions := buildAccessApplicationExtensions(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Access Application from struct: %+v, %+v", newAccessApplication, extensions))
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
@@ -72,7 +177,11 @@ func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.Re
 	}
 
 	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
+	if appType == "infrastructure" {
+		var created infrastructureAccessApplication
+		err = rawAPIRequest(ctx, client, http.MethodPost, accessApplicationsURI(identifier), infrastructureAccessApplication{newAccessApplication, extensions}, &created)
+		accessApplication = created.AccessApplication
+	} else if identifier.Type == AccountType {
 		accessApplication, err = client.CreateAccessApplication(ctx, identifier.Value, newAccessApplication)
 	} else {
 		accessApplication, err = client.CreateZoneLevelAccessApplication(ctx, identifier.Value, newAccessApplication)
@@ -83,6 +192,12 @@ func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.Re
 
 	d.SetId(accessApplication.ID)
 
+	if appType != "infrastructure" && !extensions.isEmpty() {
+		if err := updateAccessApplicationExtensions(ctx, client, identifier, d.Id(), extensions); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCloudflareAccessApplicationRead(ctx, d, meta)
 }
 
@@ -111,6 +226,11 @@ func resourceCloudflareAccessApplicationRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf("error finding Access Application %q: %w", d.Id(), err))
 	}
 
+	var extensions accessApplicationExtensions
+	if err := rawAPIRequest(ctx, client, http.MethodGet, fmt.Sprintf("%s/%s", accessApplicationsURI(identifier), d.Id()), nil, &extensions); err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Access Application %q: %w", d.Id(), err))
+	}
+
 	d.Set("name", accessApplication.Name)
 	d.Set("aud", accessApplication.AUD)
 	d.Set("session_duration", accessApplication.SessionDuration)
@@ -127,12 +247,25 @@ func resourceCloudflareAccessApplicationRead(ctx context.Context, d *schema.Reso
 	d.Set("logo_url", accessApplication.LogoURL)
 	d.Set("app_launcher_visible", accessApplication.AppLauncherVisible)
 	d.Set("service_auth_401_redirect", accessApplication.ServiceAuth401Redirect)
+	d.Set("tags", extensions.Tags)
+	d.Set("options_preflight_bypass", extensions.OptionsPreflightBypass)
+	d.Set("path_cookie_attribute", extensions.PathCookieAttribute)
+	if extensions.AllowAuthenticateViaWarp != nil {
+		d.Set("allow_authenticate_via_warp", *extensions.AllowAuthenticateViaWarp)
+	} else {
+		d.Set("allow_authenticate_via_warp", nil)
+	}
 
 	corsConfig := convertCORSStructToSchema(d, accessApplication.CorsHeaders)
 	if corsConfigErr := d.Set("cors_headers", corsConfig); corsConfigErr != nil {
 		return diag.FromErr(fmt.Errorf("error setting Access Application CORS header configuration: %w", corsConfigErr))
 	}
 
+	targetCriteria := convertTargetCriteriaStructToSchema(d, extensions.TargetCriteria)
+	if err := d.Set("target_criteria", targetCriteria); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Access Application target criteria: %w", err))
+	}
+
 	return nil
 }
 
@@ -172,7 +305,9 @@ func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.Re
 		updatedAccessApplication.CorsHeaders = CORSConfig
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Access Application from struct: %+v", updatedAccessApplication))
+	extensions := buildAccessApplicationExtensions(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Access Application from struct: %+v, %+v", updatedAccessApplication, extensions))
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
@@ -180,7 +315,11 @@ func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.Re
 	}
 
 	var accessApplication cloudflare.AccessApplication
-	if identifier.Type == AccountType {
+	if appType == "infrastructure" {
+		var updated infrastructureAccessApplication
+		err = rawAPIRequest(ctx, client, http.MethodPut, fmt.Sprintf("%s/%s", accessApplicationsURI(identifier), d.Id()), infrastructureAccessApplication{updatedAccessApplication, extensions}, &updated)
+		accessApplication = updated.AccessApplication
+	} else if identifier.Type == AccountType {
 		accessApplication, err = client.UpdateAccessApplication(ctx, identifier.Value, updatedAccessApplication)
 	} else {
 		accessApplication, err = client.UpdateZoneLevelAccessApplication(ctx, identifier.Value, updatedAccessApplication)
@@ -193,6 +332,12 @@ func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.Re
 		return diag.FromErr(fmt.Errorf("failed to find Access Application ID in update response; resource was empty"))
 	}
 
+	if appType != "infrastructure" && (!extensions.isEmpty() || d.HasChanges("tags", "options_preflight_bypass", "path_cookie_attribute", "allow_authenticate_via_warp")) {
+		if err := updateAccessApplicationExtensions(ctx, client, identifier, d.Id(), extensions); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCloudflareAccessApplicationRead(ctx, d, meta)
 }
 
@@ -245,3 +390,61 @@ func resourceCloudflareAccessApplicationImport(ctx context.Context, d *schema.Re
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// accessTagsPerPage is the page size used when listing the Access Tags of an
+// account.
+const accessTagsPerPage = 50
+
+// resourceCloudflareAccessApplicationValidateTags ensures every tag referenced
+// by an Access Application already exists on the account during plan, as the
+// API rejects unknown tags without naming them.
+func resourceCloudflareAccessApplicationValidateTags(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	accountID := d.Get("account_id").(string)
+	if accountID == "" || !d.NewValueKnown("account_id") || !d.NewValueKnown("tags") || !d.HasChange("tags") {
+		return nil
+	}
+
+	tags := expandInterfaceToStringList(d.Get("tags").(*schema.Set).List())
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return validateAccessApplicationTags(ctx, meta.(*cloudflare.API), accountID, tags)
+}
+
+// validateAccessApplicationTags returns an error naming the tags that don't
+// exist in the account.
+func validateAccessApplicationTags(ctx context.Context, client *cloudflare.API, accountID string, tags []string) error {
+	known := make(map[string]bool)
+	for page := 1; ; page++ {
+		var existingTags []struct {
+			Name string `json:"name"`
+		}
+		uri := fmt.Sprintf("/accounts/%s/access/tags?page=%d&per_page=%d", accountID, page, accessTagsPerPage)
+		if err := rawAPIRequest(ctx, client, http.MethodGet, uri, nil, &existingTags); err != nil {
+			return fmt.Errorf("error listing Access Tags for account %q: %w", accountID, err)
+		}
+
+		for _, tag := range existingTags {
+			known[tag.Name] = true
+		}
+
+		if len(existingTags) < accessTagsPerPage {
+			break
+		}
+	}
+
+	var missing []string
+	for _, tag := range tags {
+		if !known[tag] {
+			missing = append(missing, tag)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("access tags %s do not exist in account %q; create them before referencing them", strings.Join(missing, ", "), accountID)
+	}
+
+	return nil
+}
@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
@@ -365,6 +368,130 @@ func TestAccCloudflareAccessApplication_WithAppLauncherVisible(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessApplication_WithAllowAuthenticateViaWarp(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithAllowAuthenticateViaWarp(rnd, zoneID, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "allow_authenticate_via_warp", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithAllowAuthenticateViaWarp(rnd, zoneID, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "allow_authenticate_via_warp", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessApplication_WithOptionsPreflightBypass(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithOptionsPreflightBypass(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "options_preflight_bypass", "true"),
+					resource.TestCheckResourceAttr(name, "path_cookie_attribute", "true"),
+					resource.TestCheckResourceAttr(name, "same_site_cookie_attribute", "strict"),
+					resource.TestCheckResourceAttr(name, "http_only_cookie_attribute", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessApplication_WithMissingTags(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareAccessApplicationConfigWithTags(rnd, accountID, domain, []string{rnd + "-missing-b", rnd + "-missing-a"}),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Access Tags %[1]s-missing-a, %[1]s-missing-b do not exist", rnd)),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessApplication_WithTargetCriteria(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithTargetCriteria(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "type", "infrastructure"),
+					resource.TestCheckResourceAttr(name, "target_criteria.#", "1"),
+					resource.TestCheckResourceAttr(name, "target_criteria.0.port", "22"),
+					resource.TestCheckResourceAttr(name, "target_criteria.0.protocol", "SSH"),
+					resource.TestCheckResourceAttr(name, "target_criteria.0.target_attributes.#", "1"),
+					resource.TestCheckResourceAttr(name, "target_criteria.0.target_attributes.0.name", "hostname"),
+					resource.TestCheckResourceAttr(name, "target_criteria.0.target_attributes.0.values.0", rnd),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessApplication_WithInvalidTargetCriteria(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareAccessApplicationConfigWithInvalidTargetCriteria(rnd, accountID, "SSH", 0),
+				ExpectError: regexp.MustCompile(`expected target_criteria.0.port to be in the range \(1 - 65535\), got 0`),
+			},
+			{
+				Config:      testAccCloudflareAccessApplicationConfigWithInvalidTargetCriteria(rnd, accountID, "VNC", 22),
+				ExpectError: regexp.MustCompile(`expected target_criteria.0.protocol to be one of \[SSH RDP\], got VNC`),
+			},
+		},
+	})
+}
+
 func testAccCloudflareAccessApplicationConfigBasic(rnd string, domain string, identifier AccessIdentifier) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_application" "%[1]s" {
@@ -378,6 +505,59 @@ resource "cloudflare_access_application" "%[1]s" {
 `, rnd, domain, identifier.Type, identifier.Value)
 }
 
+func testAccCloudflareAccessApplicationConfigWithTargetCriteria(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  type       = "infrastructure"
+
+  target_criteria {
+    port     = 22
+    protocol = "SSH"
+
+    target_attributes {
+      name   = "hostname"
+      values = ["%[1]s"]
+    }
+  }
+}
+`, rnd, accountID)
+}
+
+func testAccCloudflareAccessApplicationConfigWithInvalidTargetCriteria(rnd, accountID, protocol string, port int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  type       = "infrastructure"
+
+  target_criteria {
+    port     = %[4]d
+    protocol = "%[3]s"
+
+    target_attributes {
+      name   = "hostname"
+      values = ["%[1]s"]
+    }
+  }
+}
+`, rnd, accountID, protocol, port)
+}
+
+func testAccCloudflareAccessApplicationConfigWithTags(rnd, accountID, domain string, tags []string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  account_id       = "%[2]s"
+  name             = "%[1]s"
+  domain           = "%[1]s.%[3]s"
+  type             = "self_hosted"
+  session_duration = "24h"
+  tags             = ["%[4]s"]
+}
+`, rnd, accountID, domain, strings.Join(tags, `", "`))
+}
+
 func testAccCloudflareAccessApplicationConfigWithCORS(rnd, zoneID, domain string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_application" "%[1]s" {
@@ -521,6 +701,35 @@ resource "cloudflare_access_application" "%[1]s" {
 `, rnd, zoneID, domain)
 }
 
+func testAccCloudflareAccessApplicationConfigWithAllowAuthenticateViaWarp(rnd, zoneID, domain string, allowWarp bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  zone_id                     = "%[2]s"
+  name                        = "%[1]s"
+  domain                      = "%[1]s.%[3]s"
+  type                        = "self_hosted"
+  session_duration            = "24h"
+  allow_authenticate_via_warp = %[4]t
+}
+`, rnd, zoneID, domain, allowWarp)
+}
+
+func testAccCloudflareAccessApplicationConfigWithOptionsPreflightBypass(rnd, zoneID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s" {
+  zone_id                    = "%[2]s"
+  name                       = "%[1]s"
+  domain                     = "%[1]s.%[3]s/api"
+  type                       = "self_hosted"
+  session_duration           = "24h"
+  options_preflight_bypass   = true
+  path_cookie_attribute      = true
+  same_site_cookie_attribute = "strict"
+  http_only_cookie_attribute = true
+}
+`, rnd, zoneID, domain)
+}
+
 func testAccCloudflareAccessApplicationConfigLogoURL(rnd, zoneID, domain string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_application" "%[1]s" {
@@ -791,3 +1000,281 @@ func testAccessApplicationMisconfiguredCORSAllowWildcardOriginWithCredentials(re
   }
   `, resourceID, zone, zoneID)
 }
+
+func TestAccessApplicationPlanRejectsMissingTags(t *testing.T) {
+	var pages []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/tags", func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+
+		var tags []string
+		if page == "1" {
+			for i := 0; i < accessTagsPerPage; i++ {
+				tags = append(tags, fmt.Sprintf(`{"name": "tag-%d", "app_count": 1}`, i))
+			}
+		} else {
+			tags = append(tags, `{"name": "engineering", "app_count": 2}`)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [%s]}`, strings.Join(tags, ","))
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/apps", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request planning an Access Application with missing tags", r.Method)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	for name, tc := range map[string]struct {
+		tags        []interface{}
+		expectError string
+	}{
+		"missing tags": {
+			tags:        []interface{}{"hr", "engineering", "tag-3", "finance"},
+			expectError: fmt.Sprintf("access tags finance, hr do not exist in account %q", testAccCloudflareAccountID),
+		},
+		"tag from a later page": {
+			tags: []interface{}{"engineering", "tag-49"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			pages = nil
+
+			_, err := resourceCloudflareAccessApplication().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"account_id": testAccCloudflareAccountID,
+				"name":       "example",
+				"domain":     "example.com",
+				"tags":       tc.tags,
+			}), client)
+
+			if tc.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tc.expectError) {
+				t.Errorf("expected error %q, got %v", tc.expectError, err)
+			}
+
+			if fmt.Sprint(pages) != "[1 2]" {
+				t.Errorf("expected both pages of Access Tags to be listed, got %v", pages)
+			}
+		})
+	}
+}
+
+func TestAccessApplicationSendsWarpAndCookieSettings(t *testing.T) {
+	var created, patched map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/apps", func(w http.ResponseWriter, r *http.Request) {
+		if !decodeTestRequestBody(t, w, r, &created) {
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db"}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/apps/480f4f69-1a28-4fdd-9240-1ed29f0ac1db", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch && !decodeTestRequestBody(t, w, r, &patched) {
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+			"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+			"name": "example",
+			"domain": "example.com",
+			"type": "self_hosted",
+			"options_preflight_bypass": true,
+			"path_cookie_attribute": true,
+			"allow_authenticate_via_warp": false
+		}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAccessApplicationSchema(), map[string]interface{}{
+		"account_id":                  testAccCloudflareAccountID,
+		"name":                        "example",
+		"domain":                      "example.com",
+		"options_preflight_bypass":    true,
+		"path_cookie_attribute":       true,
+		"allow_authenticate_via_warp": false,
+	})
+
+	if diags := resourceCloudflareAccessApplicationCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for field, expected := range map[string]interface{}{
+		"options_preflight_bypass":    true,
+		"path_cookie_attribute":       true,
+		"allow_authenticate_via_warp": false,
+	} {
+		if _, ok := created[field]; ok {
+			t.Errorf("expected %s to be left out of the cloudflare-go create request, got %v", field, created[field])
+		}
+		if patched[field] != expected {
+			t.Errorf("expected %s to be sent as %v, got %v", field, expected, patched[field])
+		}
+	}
+
+	if !d.Get("options_preflight_bypass").(bool) || !d.Get("path_cookie_attribute").(bool) {
+		t.Error("expected options_preflight_bypass and path_cookie_attribute to be read back")
+	}
+	if value, ok := d.GetOkExists("allow_authenticate_via_warp"); !ok || value.(bool) {
+		t.Errorf("expected allow_authenticate_via_warp to be read back as false, got %v", value)
+	}
+}
+
+func TestAccessApplicationSendsTargetCriteria(t *testing.T) {
+	var created map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/apps", func(w http.ResponseWriter, r *http.Request) {
+		if !decodeTestRequestBody(t, w, r, &created) {
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db"}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/apps/480f4f69-1a28-4fdd-9240-1ed29f0ac1db", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+			"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+			"name": "example",
+			"type": "infrastructure",
+			"target_criteria": [{
+				"port": 22,
+				"protocol": "SSH",
+				"target_attributes": {"virtual_network_id": ["vnet"], "hostname": ["bastion", "db"]}
+			}]
+		}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAccessApplicationSchema(), map[string]interface{}{
+		"account_id": testAccCloudflareAccountID,
+		"name":       "example",
+		"type":       "infrastructure",
+		"target_criteria": []interface{}{
+			map[string]interface{}{
+				"port":     22,
+				"protocol": "SSH",
+				"target_attributes": []interface{}{
+					map[string]interface{}{"name": "virtual_network_id", "values": []interface{}{"vnet"}},
+					map[string]interface{}{"name": "hostname", "values": []interface{}{"bastion", "db"}},
+				},
+			},
+		},
+	})
+
+	if diags := resourceCloudflareAccessApplicationCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	criteria, ok := created["target_criteria"].([]interface{})
+	if !ok || len(criteria) != 1 {
+		t.Fatalf("expected a single target criteria to be sent, got %v", created["target_criteria"])
+	}
+	sent := criteria[0].(map[string]interface{})
+	if sent["port"] != float64(22) || sent["protocol"] != "SSH" {
+		t.Errorf("expected port 22 and protocol SSH to be sent, got %v", sent)
+	}
+	hostnames := sent["target_attributes"].(map[string]interface{})["hostname"].([]interface{})
+	if len(hostnames) != 2 || hostnames[0] != "bastion" || hostnames[1] != "db" {
+		t.Errorf("expected hostname target attribute to be sent as [bastion db], got %v", hostnames)
+	}
+
+	for field, expected := range map[string]string{
+		"target_criteria.0.port":                         "22",
+		"target_criteria.0.target_attributes.0.name":     "virtual_network_id",
+		"target_criteria.0.target_attributes.1.name":     "hostname",
+		"target_criteria.0.target_attributes.1.values.1": "db",
+	} {
+		if actual := fmt.Sprint(d.Get(field)); actual != expected {
+			t.Errorf("expected %s to be %q, got %q", field, expected, actual)
+		}
+	}
+}
+
+func TestAccessApplicationValidateType(t *testing.T) {
+	for name, tc := range map[string]struct {
+		config      map[string]interface{}
+		expectError string
+	}{
+		"self hosted without domain": {
+			config:      map[string]interface{}{"account_id": testAccCloudflareAccountID, "name": "example"},
+			expectError: `domain must be set for Access Applications of type "self_hosted"`,
+		},
+		"infrastructure without target criteria": {
+			config:      map[string]interface{}{"account_id": testAccCloudflareAccountID, "name": "example", "type": "infrastructure"},
+			expectError: `target_criteria must be set for Access Applications of type "infrastructure"`,
+		},
+		"self hosted with target criteria": {
+			config: map[string]interface{}{
+				"account_id": testAccCloudflareAccountID,
+				"name":       "example",
+				"domain":     "example.com",
+				"target_criteria": []interface{}{
+					map[string]interface{}{
+						"port":              22,
+						"protocol":          "SSH",
+						"target_attributes": []interface{}{map[string]interface{}{"name": "hostname", "values": []interface{}{"bastion"}}},
+					},
+				},
+			},
+			expectError: `target_criteria can only be set for Access Applications of type "infrastructure", got "self_hosted"`,
+		},
+		"self hosted with domain": {
+			config: map[string]interface{}{"account_id": testAccCloudflareAccountID, "name": "example", "domain": "example.com"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := resourceCloudflareAccessApplication().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+			if tc.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectError) {
+				t.Errorf("expected error %q, got %v", tc.expectError, err)
+			}
+		})
+	}
+}
+
+func TestAccessApplicationUnsetAllowAuthenticateViaWarpDoesNotDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+		Attributes: map[string]string{
+			"id":                          "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+			"account_id":                  testAccCloudflareAccountID,
+			"name":                        "example",
+			"domain":                      "example.com",
+			"type":                        "self_hosted",
+			"session_duration":            "24h",
+			"allow_authenticate_via_warp": "true",
+		},
+	}
+
+	diff, err := resourceCloudflareAccessApplication().Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id": testAccCloudflareAccountID,
+		"name":       "example",
+		"domain":     "example.com",
+	}), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff != nil {
+		if attr, ok := diff.Attributes["allow_authenticate_via_warp"]; ok {
+			t.Errorf("expected the value of allow_authenticate_via_warp set by the API to be kept, got %q => %q", attr.Old, attr.New)
+		}
+	}
+}
@@ -21,7 +21,8 @@ func resourceCloudflareAccessGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAccessGroupImport,
 		},
-		Description: "Provides a Cloudflare Access Group resource. Access Groups are used in conjunction with Access Policies to restrict access to a particular resource based on group membership.",
+		CustomizeDiff: resourceCloudflareAccessGroupValidateInclude,
+		Description:   "Provides a Cloudflare Access Group resource. Access Groups are used in conjunction with Access Policies to restrict access to a particular resource based on group membership.",
 	}
 }
 
@@ -171,6 +172,25 @@ func resourceCloudflareAccessGroupImport(ctx context.Context, d *schema.Resource
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceCloudflareAccessGroupValidateInclude ensures that the `include`
+// block contains at least one condition. A group made up of only `exclude`
+// (or `require`) conditions has no members and is rejected by the API.
+func resourceCloudflareAccessGroupValidateInclude(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Conditions that are not known until apply time can't be inspected yet.
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.GetAttr("include").IsWhollyKnown() {
+		return nil
+	}
+
+	for _, value := range d.Get("include").([]interface{}) {
+		if value != nil && len(BuildAccessGroupCondition(value.(map[string]interface{}))) > 0 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("access group %q must define at least one condition in `include`; to match everyone that isn't excluded, set `everyone = true` in the `include` block", d.Get("name").(string))
+}
+
 // appendConditionalAccessGroupFields determines which of the
 // conditional group enforcement fields it should append to the
 // AccessGroup by iterating over the provided values and generating the
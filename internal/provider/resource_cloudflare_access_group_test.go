@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -143,6 +144,24 @@ func TestAccCloudflareAccessGroup_Exclude(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessGroup_ExcludeOnly(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccessGroupConfigExcludeOnly(rnd, accountID, email),
+				ExpectError: regexp.MustCompile("must define at least one condition in `include`"),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareAccessGroup_Require(t *testing.T) {
 	rnd := generateRandomResourceName()
 	name := fmt.Sprintf("cloudflare_access_group.%s", rnd)
@@ -332,6 +351,20 @@ resource "cloudflare_access_group" "%[1]s" {
 }`, resourceName, accountID, email)
 }
 
+func testAccessGroupConfigExcludeOnly(resourceName, accountID, email string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_group" "%[1]s" {
+  account_id = "%[2]s"
+  name = "%[1]s"
+
+  include {}
+
+  exclude {
+    email = ["%[3]s"]
+  }
+}`, resourceName, accountID, email)
+}
+
 func testAccessGroupConfigRequire(resourceName, accountID, email string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_group" "%[1]s" {
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -25,6 +26,31 @@ func resourceCloudflareAccessPolicy() *schema.Resource {
 	}
 }
 
+// accessPolicyDetails extends the Access Policy of cloudflare-go with the fields it
+// doesn't support yet. Policies only go through it, and a raw API request,
+// when connection rules are involved.
+type accessPolicyDetails struct {
+	cloudflare.AccessPolicy
+	ConnectionRules *accessPolicyConnectionRules `json:"connection_rules"`
+}
+
+// accessPolicyConnectionRules configures how users may connect to the targets
+// of an infrastructure Access Application.
+type accessPolicyConnectionRules struct {
+	SSH *accessPolicyConnectionRulesSSH `json:"ssh,omitempty"`
+}
+
+type accessPolicyConnectionRulesSSH struct {
+	Usernames       []string `json:"usernames"`
+	AllowEmailAlias bool     `json:"allow_email_alias"`
+}
+
+// accessPoliciesURI returns the Access Policies endpoint of the application
+// for the account or zone the resource targets.
+func accessPoliciesURI(identifier *AccessIdentifier, appID string) string {
+	return fmt.Sprintf("%s/%s/policies", accessApplicationsURI(identifier), appID)
+}
+
 func apiAccessPolicyApprovalGroupToSchema(approvalGroup cloudflare.AccessApprovalGroup) map[string]interface{} {
 	data := make(map[string]interface{})
 	data["approvals_needed"] = approvalGroup.ApprovalsNeeded
@@ -52,6 +78,35 @@ func schemaAccessPolicyApprovalGroupToAPI(data map[string]interface{}) cloudflar
 	return approvalGroup
 }
 
+func schemaAccessPolicyConnectionRulesToAPI(d *schema.ResourceData) *accessPolicyConnectionRules {
+	if _, ok := d.GetOk("connection_rules"); !ok {
+		return nil
+	}
+
+	connectionRules := &accessPolicyConnectionRules{}
+	if _, ok := d.GetOk("connection_rules.0.ssh"); ok {
+		connectionRules.SSH = &accessPolicyConnectionRulesSSH{
+			Usernames:       expandInterfaceToStringList(d.Get("connection_rules.0.ssh.0.usernames")),
+			AllowEmailAlias: d.Get("connection_rules.0.ssh.0.allow_email_alias").(bool),
+		}
+	}
+
+	return connectionRules
+}
+
+func apiAccessPolicyConnectionRulesToSchema(connectionRules *accessPolicyConnectionRules) []interface{} {
+	if connectionRules == nil || connectionRules.SSH == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"ssh": []interface{}{map[string]interface{}{
+			"usernames":         connectionRules.SSH.Usernames,
+			"allow_email_alias": connectionRules.SSH.AllowEmailAlias,
+		}},
+	}}
+}
+
 func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	appID := d.Get("application_id").(string)
@@ -67,6 +122,7 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 	} else {
 		accessPolicy, err = client.ZoneLevelAccessPolicy(ctx, identifier.Value, appID, d.Id())
 	}
+
 	if err != nil {
 		if strings.Contains(err.Error(), "HTTP status 404") {
 			tflog.Info(ctx, fmt.Sprintf("Access Policy %s no longer exists", d.Id()))
@@ -114,6 +170,29 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if _, ok := d.GetOk("connection_rules"); ok {
+		if err := resourceCloudflareAccessPolicyReadConnectionRules(ctx, d, client, identifier, appID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// resourceCloudflareAccessPolicyReadConnectionRules reads the connection rules
+// of the policy, which cloudflare-go doesn't support yet. They only apply to
+// infrastructure applications so they're only read when already managed or
+// when the policy is imported.
+func resourceCloudflareAccessPolicyReadConnectionRules(ctx context.Context, d *schema.ResourceData, client *cloudflare.API, identifier *AccessIdentifier, appID string) error {
+	var accessPolicy accessPolicyDetails
+	if err := rawAPIRequest(ctx, client, http.MethodGet, fmt.Sprintf("%s/%s", accessPoliciesURI(identifier, appID), d.Id()), nil, &accessPolicy); err != nil {
+		return fmt.Errorf("error finding Access Policy %q connection rules: %w", d.Id(), err)
+	}
+
+	if err := d.Set("connection_rules", apiAccessPolicyConnectionRulesToSchema(accessPolicy.ConnectionRules)); err != nil {
+		return fmt.Errorf("failed to set connection_rules attribute: %w", err)
+	}
+
 	return nil
 }
 
@@ -136,7 +215,11 @@ func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
+	if connectionRules := schemaAccessPolicyConnectionRulesToAPI(d); connectionRules != nil {
+		var createdAccessPolicy accessPolicyDetails
+		err = rawAPIRequest(ctx, client, http.MethodPost, accessPoliciesURI(identifier, appID), accessPolicyDetails{AccessPolicy: newAccessPolicy, ConnectionRules: connectionRules}, &createdAccessPolicy)
+		accessPolicy = createdAccessPolicy.AccessPolicy
+	} else if identifier.Type == AccountType {
 		accessPolicy, err = client.CreateAccessPolicy(ctx, identifier.Value, appID, newAccessPolicy)
 	} else {
 		accessPolicy, err = client.CreateZoneLevelAccessPolicy(ctx, identifier.Value, appID, newAccessPolicy)
@@ -169,8 +252,13 @@ func resourceCloudflareAccessPolicyUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	// Connection rules which are removed are sent as null to clear them.
 	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
+	if connectionRules := schemaAccessPolicyConnectionRulesToAPI(d); connectionRules != nil || d.HasChange("connection_rules") {
+		var updated accessPolicyDetails
+		err = rawAPIRequest(ctx, client, http.MethodPut, fmt.Sprintf("%s/%s", accessPoliciesURI(identifier, appID), d.Id()), accessPolicyDetails{AccessPolicy: updatedAccessPolicy, ConnectionRules: connectionRules}, &updated)
+		accessPolicy = updated.AccessPolicy
+	} else if identifier.Type == AccountType {
 		accessPolicy, err = client.UpdateAccessPolicy(ctx, identifier.Value, appID, updatedAccessPolicy)
 	} else {
 		accessPolicy, err = client.UpdateZoneLevelAccessPolicy(ctx, identifier.Value, appID, updatedAccessPolicy)
@@ -234,6 +322,12 @@ func resourceCloudflareAccessPolicyImport(ctx context.Context, d *schema.Resourc
 
 	resourceCloudflareAccessPolicyRead(ctx, d, meta)
 
+	if identifier, err := initIdentifier(d); err == nil && d.Id() != "" {
+		if err := resourceCloudflareAccessPolicyReadConnectionRules(ctx, d, meta.(*cloudflare.API), identifier, accessAppID); err != nil {
+			return nil, err
+		}
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -1,11 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccCloudflareAccessPolicy_ServiceToken(t *testing.T) {
@@ -866,3 +869,172 @@ func testAccessPolicyExternalEvalautionConfig(resourceID, zone, accountID string
 
   `, resourceID, zone, accountID)
 }
+
+func TestAccCloudflareAccessPolicy_ConnectionRules(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_access_policy." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccessPolicyConnectionRulesConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "connection_rules.#", "1"),
+					resource.TestCheckResourceAttr(name, "connection_rules.0.ssh.0.usernames.#", "2"),
+					resource.TestCheckResourceAttr(name, "connection_rules.0.ssh.0.usernames.0", "root"),
+					resource.TestCheckResourceAttr(name, "connection_rules.0.ssh.0.usernames.1", "ubuntu"),
+					resource.TestCheckResourceAttr(name, "connection_rules.0.ssh.0.allow_email_alias", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccessPolicyConnectionRulesConfig(resourceID, accountID string) string {
+	return fmt.Sprintf(`
+    resource "cloudflare_access_application" "%[1]s" {
+      name       = "%[1]s"
+      account_id = "%[2]s"
+      type       = "infrastructure"
+
+      target_criteria {
+        port     = 22
+        protocol = "SSH"
+
+        target_attributes {
+          name   = "hostname"
+          values = ["%[1]s"]
+        }
+      }
+    }
+
+    resource "cloudflare_access_policy" "%[1]s" {
+      application_id = cloudflare_access_application.%[1]s.id
+      name           = "%[1]s"
+      account_id     = "%[2]s"
+      decision       = "allow"
+      precedence     = "1"
+
+      include {
+        email = ["a@example.com", "b@example.com"]
+      }
+
+      connection_rules {
+        ssh {
+          usernames         = ["root", "ubuntu"]
+          allow_email_alias = true
+        }
+      }
+    }
+  `, resourceID, accountID)
+}
+
+func TestAccessPolicySendsConnectionRules(t *testing.T) {
+	var updated map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/apps/app-id/policies/policy-id", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			if !decodeTestRequestBody(t, w, r, &updated) {
+				return
+			}
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+			"id": "policy-id",
+			"name": "example",
+			"decision": "allow",
+			"precedence": 1,
+			"include": [{"email": {"email": "a@example.com"}}],
+			"connection_rules": {"ssh": {"usernames": ["root"], "allow_email_alias": true}}
+		}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAccessPolicySchema(), map[string]interface{}{
+		"account_id":     testAccCloudflareAccountID,
+		"application_id": "app-id",
+		"name":           "example",
+		"decision":       "allow",
+		"precedence":     1,
+		"include": []interface{}{
+			map[string]interface{}{"email": []interface{}{"a@example.com"}},
+		},
+		"connection_rules": []interface{}{
+			map[string]interface{}{
+				"ssh": []interface{}{
+					map[string]interface{}{"usernames": []interface{}{"root"}, "allow_email_alias": true},
+				},
+			},
+		},
+	})
+	d.SetId("policy-id")
+
+	if diags := resourceCloudflareAccessPolicyUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	ssh, ok := updated["connection_rules"].(map[string]interface{})["ssh"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected SSH connection rules to be sent, got %v", updated["connection_rules"])
+	}
+	if usernames := ssh["usernames"].([]interface{}); len(usernames) != 1 || usernames[0] != "root" {
+		t.Errorf("expected usernames to be sent as [root], got %v", usernames)
+	}
+	if ssh["allow_email_alias"] != true {
+		t.Errorf("expected allow_email_alias to be sent as true, got %v", ssh["allow_email_alias"])
+	}
+
+	if username := d.Get("connection_rules.0.ssh.0.usernames.0").(string); username != "root" {
+		t.Errorf("expected connection_rules.0.ssh.0.usernames.0 to be read back as root, got %q", username)
+	}
+	if !d.Get("connection_rules.0.ssh.0.allow_email_alias").(bool) {
+		t.Error("expected connection_rules.0.ssh.0.allow_email_alias to be read back as true")
+	}
+}
+
+func TestAccessPolicyReadOnlyRequestsConnectionRulesWhenManaged(t *testing.T) {
+	requests := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/apps/app-id/policies/policy-id", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+			"id": "policy-id",
+			"name": "example",
+			"decision": "allow",
+			"precedence": 1,
+			"include": [{"email": {"email": "a@example.com"}}]
+		}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAccessPolicySchema(), map[string]interface{}{
+		"account_id":     testAccCloudflareAccountID,
+		"application_id": "app-id",
+		"name":           "example",
+		"decision":       "allow",
+		"precedence":     1,
+	})
+	d.SetId("policy-id")
+
+	if diags := resourceCloudflareAccessPolicyRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected a single request for a policy without connection rules, got %d", requests)
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 
@@ -28,6 +29,38 @@ func resourceCloudflareAccessServiceToken() *schema.Resource {
 	}
 }
 
+// accessServiceTokenRequest is the body used to create and update an Access
+// Service Token, including the duration which cloudflare-go doesn't support
+// yet.
+type accessServiceTokenRequest struct {
+	Name     string `json:"name"`
+	Duration string `json:"duration,omitempty"`
+}
+
+// accessServiceToken extends the Access Service Token of cloudflare-go with
+// its duration.
+type accessServiceToken struct {
+	cloudflare.AccessServiceTokenCreateResponse
+	Duration string `json:"duration"`
+}
+
+// accessServiceTokensURI returns the Access Service Tokens endpoint for the
+// account or zone the resource targets.
+func accessServiceTokensURI(identifier *AccessIdentifier) string {
+	return fmt.Sprintf("/%ss/%s/access/service_tokens", identifier.Type, identifier.Value)
+}
+
+// setAccessServiceTokenExpiry sets `expires_at`, which is empty for tokens
+// that never expire.
+func setAccessServiceTokenExpiry(d *schema.ResourceData, expiresAt *time.Time) {
+	if expiresAt == nil {
+		d.Set("expires_at", "")
+		return
+	}
+
+	d.Set("expires_at", expiresAt.Format(time.RFC3339))
+}
+
 func resourceCloudflareAccessServiceTokenExpireDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 	mindays := d.Get("min_days_for_renewal").(int)
 	if mindays > 0 {
@@ -62,12 +95,8 @@ func resourceCloudflareAccessServiceTokenRead(ctx context.Context, d *schema.Res
 	// The Cloudflare API doesn't support fetching a single service token
 	// so instead we loop over all the service tokens and only continue
 	// when we have a match.
-	var serviceTokens []cloudflare.AccessServiceToken
-	if identifier.Type == AccountType {
-		serviceTokens, _, err = client.AccessServiceTokens(ctx, identifier.Value)
-	} else {
-		serviceTokens, _, err = client.ZoneLevelAccessServiceTokens(ctx, identifier.Value)
-	}
+	var serviceTokens []accessServiceToken
+	err = rawAPIRequest(ctx, client, http.MethodGet, accessServiceTokensURI(identifier), nil, &serviceTokens)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error fetching access service tokens: %w", err))
 	}
@@ -75,7 +104,10 @@ func resourceCloudflareAccessServiceTokenRead(ctx context.Context, d *schema.Res
 		if token.ID == d.Id() {
 			d.Set("name", token.Name)
 			d.Set("client_id", token.ClientID)
-			d.Set("expires_at", token.ExpiresAt.Format(time.RFC3339))
+			setAccessServiceTokenExpiry(d, token.ExpiresAt)
+			if token.Duration != "" {
+				d.Set("duration", token.Duration)
+			}
 		}
 	}
 
@@ -91,12 +123,13 @@ func resourceCloudflareAccessServiceTokenCreate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	var serviceToken cloudflare.AccessServiceTokenCreateResponse
-	if identifier.Type == AccountType {
-		serviceToken, err = client.CreateAccessServiceToken(ctx, identifier.Value, tokenName)
-	} else {
-		serviceToken, err = client.CreateZoneLevelAccessServiceToken(ctx, identifier.Value, tokenName)
+	newServiceToken := accessServiceTokenRequest{
+		Name:     tokenName,
+		Duration: d.Get("duration").(string),
 	}
+
+	var serviceToken accessServiceToken
+	err = rawAPIRequest(ctx, client, http.MethodPost, accessServiceTokensURI(identifier), newServiceToken, &serviceToken)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating access service token: %w", err))
 	}
@@ -105,7 +138,7 @@ func resourceCloudflareAccessServiceTokenCreate(ctx context.Context, d *schema.R
 	d.Set("name", serviceToken.Name)
 	d.Set("client_id", serviceToken.ClientID)
 	d.Set("client_secret", serviceToken.ClientSecret)
-	d.Set("expires_at", serviceToken.ExpiresAt.Format(time.RFC3339))
+	setAccessServiceTokenExpiry(d, serviceToken.ExpiresAt)
 
 	resourceCloudflareAccessServiceTokenRead(ctx, d, meta)
 
@@ -121,12 +154,13 @@ func resourceCloudflareAccessServiceTokenUpdate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	var serviceToken cloudflare.AccessServiceTokenUpdateResponse
-	if identifier.Type == AccountType {
-		serviceToken, err = client.UpdateAccessServiceToken(ctx, identifier.Value, d.Id(), tokenName)
-	} else {
-		serviceToken, err = client.UpdateZoneLevelAccessServiceToken(ctx, identifier.Value, d.Id(), tokenName)
+	updatedServiceToken := accessServiceTokenRequest{
+		Name:     tokenName,
+		Duration: d.Get("duration").(string),
 	}
+
+	var serviceToken accessServiceToken
+	err = rawAPIRequest(ctx, client, http.MethodPut, fmt.Sprintf("%s/%s", accessServiceTokensURI(identifier), d.Id()), updatedServiceToken, &serviceToken)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating access service token: %w", err))
 	}
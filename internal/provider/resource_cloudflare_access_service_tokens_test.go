@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -255,6 +257,139 @@ func TestAccCloudflareAccessServiceTokenDelete(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessServiceTokenWithDuration(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// Service Tokens endpoint does not yet support the API tokens and it
+	// results in misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_service_token.tf-acc-%s", rnd)
+	resourceName := strings.Split(name, ".")[1]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessServiceTokenDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCloudflareAccessServiceTokenDurationConfig(resourceName, resourceName, AccessIdentifier{Type: AccountType, Value: accountID}, "8760h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", resourceName),
+					resource.TestCheckResourceAttr(name, "duration", "8760h"),
+					resource.TestCheckResourceAttrSet(name, "client_id"),
+					resource.TestCheckResourceAttrSet(name, "client_secret"),
+					resource.TestCheckResourceAttrWith(name, "expires_at", func(value string) error {
+						expiresAt, err := time.Parse(time.RFC3339, value)
+						if err != nil {
+							return err
+						}
+
+						if remaining := time.Until(expiresAt); remaining < 364*24*time.Hour || remaining > 366*24*time.Hour {
+							return fmt.Errorf("expected the token to expire in a year, expires at %s", value)
+						}
+
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccessServiceTokenCreateSendsDuration(t *testing.T) {
+	var requestBody accessServiceTokenRequest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/service_tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+
+		if r.Method == http.MethodPost {
+			if !decodeTestRequestBody(t, w, r, &requestBody) {
+				return
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+				"id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+				"name": "example",
+				"client_id": "88bf3b6d86161464f6509f7219099e57.access",
+				"client_secret": "bdd31cbc4dec990953e39163fbbb194c93313ca9f0a6e420346af9d326b1d2a5",
+				"duration": "forever"
+			}}`)
+			return
+		}
+
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{
+			"id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+			"name": "example",
+			"client_id": "88bf3b6d86161464f6509f7219099e57.access",
+			"duration": "forever"
+		}]}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := resourceCloudflareAccessServiceToken().TestResourceData()
+	d.Set("account_id", testAccCloudflareAccountID)
+	d.Set("name", "example")
+	d.Set("duration", "forever")
+
+	if diags := resourceCloudflareAccessServiceTokenCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error creating access service token: %v", diags)
+	}
+
+	if requestBody.Duration != "forever" {
+		t.Errorf("expected the duration to be sent as %q, got %q", "forever", requestBody.Duration)
+	}
+
+	if got := d.Get("duration").(string); got != "forever" {
+		t.Errorf("expected duration %q, got %q", "forever", got)
+	}
+
+	if got := d.Get("expires_at").(string); got != "" {
+		t.Errorf("expected a token that never expires to have no expires_at, got %q", got)
+	}
+}
+
+func TestValidateAccessServiceTokenDuration(t *testing.T) {
+	testCases := map[string]bool{
+		"8760h":   true,
+		"2h45m":   true,
+		"forever": true,
+		"1y":      false,
+		"0h":      false,
+		"-24h":    false,
+		"":        false,
+	}
+
+	for duration, valid := range testCases {
+		_, errs := validateAccessServiceTokenDuration(duration, "duration")
+		if valid && len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", duration, errs)
+		}
+		if !valid && len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", duration)
+		}
+	}
+}
+
+func testCloudflareAccessServiceTokenDurationConfig(resourceName string, tokenName string, identifier AccessIdentifier, duration string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_service_token" "%[1]s" {
+  %[3]s_id = "%[4]s"
+  name     = "%[2]s"
+  duration = "%[5]s"
+}`, resourceName, tokenName, identifier.Type, identifier.Value, duration)
+}
+
 func testCloudflareAccessServiceTokenBasicConfig(resourceName string, tokenName string, identifier AccessIdentifier, minDaysForRenewal int) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_service_token" "%[1]s" {
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accessUser is a user of the Access organization along with the seats it
+// occupies.
+type accessUser struct {
+	ID          string `json:"id"`
+	Email       string `json:"email"`
+	SeatUID     string `json:"seat_uid"`
+	AccessSeat  bool   `json:"access_seat"`
+	GatewaySeat bool   `json:"gateway_seat"`
+}
+
+type accessSeatUpdate struct {
+	SeatUID     string `json:"seat_uid"`
+	AccessSeat  bool   `json:"access_seat"`
+	GatewaySeat bool   `json:"gateway_seat"`
+}
+
+func resourceCloudflareAccessUserRevocation() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAccessUserRevocationSchema(),
+		CreateContext: resourceCloudflareAccessUserRevocationCreate,
+		ReadContext:   resourceCloudflareAccessUserRevocationRead,
+		UpdateContext: resourceCloudflareAccessUserRevocationUpdate,
+		DeleteContext: resourceCloudflareAccessUserRevocationDelete,
+		Description:   "Provides a Cloudflare resource for revoking the Access sessions, and optionally the seats, of a user.",
+	}
+}
+
+func resourceCloudflareAccessUserRevocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	email := d.Get("email").(string)
+
+	if err := revokeAccessUser(ctx, client, accountID, email, d.Get("revoke_seats").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(stringChecksum(fmt.Sprintf("%s/%s", accountID, email)))
+
+	return resourceCloudflareAccessUserRevocationRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccessUserRevocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	email := d.Get("email").(string)
+
+	user, err := findAccessUser(ctx, client, accountID, email)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the revocation itself can't be read back, only the seats the user
+	// currently occupies.
+	if user == nil {
+		tflog.Info(ctx, fmt.Sprintf("Access user %s no longer exists in account %s", email, accountID))
+		user = &accessUser{}
+	}
+
+	d.Set("access_seat", user.AccessSeat)
+	d.Set("gateway_seat", user.GatewaySeat)
+
+	return nil
+}
+
+func resourceCloudflareAccessUserRevocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	revokeSeats := d.Get("revoke_seats").(bool)
+
+	if d.HasChange("revoke_trigger") || d.HasChange("revoke_seats") && revokeSeats {
+		if err := revokeAccessUser(ctx, client, d.Get("account_id").(string), d.Get("email").(string), revokeSeats); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareAccessUserRevocationRead(ctx, d, meta)
+}
+
+func resourceCloudflareAccessUserRevocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A revocation can't be undone, it is only removed from state.
+	tflog.Info(ctx, fmt.Sprintf("Removing Access user revocation for %s from state", d.Get("email").(string)))
+
+	d.SetId("")
+	return nil
+}
+
+// revokeAccessUser revokes all Access tokens issued to the user and, when
+// requested, removes the seats it occupies.
+func revokeAccessUser(ctx context.Context, client *cloudflare.API, accountID, email string, revokeSeats bool) error {
+	tflog.Debug(ctx, fmt.Sprintf("Revoking Access sessions of %s in account %s", email, accountID))
+
+	if err := client.RevokeAccessUserTokens(ctx, accountID, cloudflare.AccessUserEmail{Email: email}); err != nil {
+		return fmt.Errorf("error revoking Access sessions of %q: %w", email, err)
+	}
+
+	if !revokeSeats {
+		return nil
+	}
+
+	user, err := findAccessUser(ctx, client, accountID, email)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.SeatUID == "" {
+		tflog.Info(ctx, fmt.Sprintf("Access user %s doesn't occupy any seats in account %s", email, accountID))
+		return nil
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Revoking Access seats of %s in account %s", email, accountID))
+
+	seats := []accessSeatUpdate{{SeatUID: user.SeatUID}}
+	if err := rawAPIRequest(ctx, client, http.MethodPatch, fmt.Sprintf("/accounts/%s/access/seats", accountID), seats, nil); err != nil {
+		return fmt.Errorf("error revoking Access seats of %q: %w", email, err)
+	}
+
+	return nil
+}
+
+// findAccessUser returns the Access user with the given email, or nil if the
+// account has no such user.
+func findAccessUser(ctx context.Context, client *cloudflare.API, accountID, email string) (*accessUser, error) {
+	var users []accessUser
+	uri := fmt.Sprintf("/accounts/%s/access/users?email=%s", accountID, url.QueryEscape(email))
+	if err := rawAPIRequest(ctx, client, http.MethodGet, uri, nil, &users); err != nil {
+		return nil, fmt.Errorf("error finding Access user %q in account %q: %w", email, accountID, err)
+	}
+
+	for _, user := range users {
+		if strings.EqualFold(user.Email, email) {
+			return &user, nil
+		}
+	}
+
+	return nil, nil
+}
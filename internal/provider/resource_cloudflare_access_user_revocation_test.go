@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccessUserRevocationRevokesWhenTriggerChanges(t *testing.T) {
+	var revocations []string
+	var seatUpdates []accessSeatUpdate
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/organizations/revoke_user", func(w http.ResponseWriter, r *http.Request) {
+		var body cloudflare.AccessUserEmail
+		if !decodeTestRequestBody(t, w, r, &body) {
+			return
+		}
+		revocations = append(revocations, body.Email)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": true}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/users", func(w http.ResponseWriter, r *http.Request) {
+		if email := r.URL.Query().Get("email"); email != "user@example.com" {
+			t.Errorf("expected users to be filtered by user@example.com, got %q", email)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+			{"id": "f1b6ef11-a4f6-4a1c-9b3e-8d5e8e4fa9f4", "email": "user@example.com", "seat_uid": "seat-1", "access_seat": true, "gateway_seat": false}
+		]}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/access/seats", func(w http.ResponseWriter, r *http.Request) {
+		if !decodeTestRequestBody(t, w, r, &seatUpdates) {
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": []}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	state := &terraform.InstanceState{
+		ID: stringChecksum(testAccCloudflareAccountID + "/user@example.com"),
+		Attributes: map[string]string{
+			"account_id":     testAccCloudflareAccountID,
+			"email":          "user@example.com",
+			"revoke_seats":   "false",
+			"revoke_trigger": "offboarded",
+			"access_seat":    "true",
+			"gateway_seat":   "false",
+		},
+	}
+
+	testCases := map[string]struct {
+		trigger       string
+		revokeSeats   bool
+		wantRevoke    bool
+		wantSeatPatch bool
+	}{
+		"unchanged trigger": {
+			trigger: "offboarded",
+		},
+		"changed trigger": {
+			trigger:    "offboarded-again",
+			wantRevoke: true,
+		},
+		"seats revoked": {
+			trigger:       "offboarded",
+			revokeSeats:   true,
+			wantRevoke:    true,
+			wantSeatPatch: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			revocations, seatUpdates = nil, nil
+
+			resource := resourceCloudflareAccessUserRevocation()
+			diff, err := resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"account_id":     testAccCloudflareAccountID,
+				"email":          "user@example.com",
+				"revoke_seats":   tc.revokeSeats,
+				"revoke_trigger": tc.trigger,
+			}), client)
+			if err != nil {
+				t.Fatalf("unexpected error planning: %s", err)
+			}
+
+			if diff != nil && !diff.Empty() {
+				if _, diags := resource.Apply(context.Background(), state, diff, client); diags.HasError() {
+					t.Fatalf("unexpected error applying: %v", diags)
+				}
+			}
+
+			if tc.wantRevoke && (len(revocations) != 1 || revocations[0] != "user@example.com") {
+				t.Errorf("expected the sessions of user@example.com to be revoked once, got %v", revocations)
+			}
+			if !tc.wantRevoke && len(revocations) != 0 {
+				t.Errorf("expected no revocation, got %v", revocations)
+			}
+
+			if tc.wantSeatPatch && (len(seatUpdates) != 1 || seatUpdates[0] != (accessSeatUpdate{SeatUID: "seat-1"})) {
+				t.Errorf("expected seat-1 to be revoked, got %+v", seatUpdates)
+			}
+			if !tc.wantSeatPatch && len(seatUpdates) != 0 {
+				t.Errorf("expected no seat updates, got %+v", seatUpdates)
+			}
+		})
+	}
+}
@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccCloudflareAccountMemberBasic(t *testing.T) {
@@ -48,3 +54,100 @@ func testCloudflareAccountMemberBasicConfig(resourceID, emailAddress string) str
     role_ids = [ "05784afa30c1afe1440e79d9351c7430" ]
   }`, resourceID, emailAddress)
 }
+
+func TestAccountMemberEmailChangeDeletesBeforeInviting(t *testing.T) {
+	var requests []string
+	member := func(id, email string) string {
+		return fmt.Sprintf(`{"success": true, "errors": [], "messages": [], "result": {
+			"id": %q,
+			"user": {"email": %q},
+			"status": "pending",
+			"roles": [{"id": "05784afa30c1afe1440e79d9351c7430"}]
+		}}`, id, email)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/members", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Email string `json:"email"`
+		}
+		if !decodeTestRequestBody(t, w, r, &body) {
+			return
+		}
+		requests = append(requests, fmt.Sprintf("%s %s", r.Method, body.Email))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, member("new-member", body.Email))
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/members/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/accounts/"+testAccCloudflareAccountID+"/members/")
+		if r.Method != http.MethodGet {
+			requests = append(requests, fmt.Sprintf("%s %s", r.Method, id))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, member(id, "new@example.com"))
+	})
+
+	client := newTestAPIClient(t, mux, cloudflare.UsingAccount(testAccCloudflareAccountID))
+
+	state := &terraform.InstanceState{
+		ID: "old-member",
+		Attributes: map[string]string{
+			"id":                  "old-member",
+			"email_address":       "old@example.com",
+			"role_ids.#":          "1",
+			"role_ids.2490201224": "05784afa30c1afe1440e79d9351c7430",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"email_address": "new@example.com",
+		"role_ids":      []interface{}{"05784afa30c1afe1440e79d9351c7430"},
+	})
+
+	resource := resourceCloudflareAccountMember()
+	diff, err := resource.Diff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatalf("unexpected error planning: %s", err)
+	}
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatal("expected an email_address change to replace the account member")
+	}
+
+	newState, diags := resource.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("unexpected error applying: %v", diags)
+	}
+
+	expected := []string{"DELETE old-member", "POST new@example.com"}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+	if newState.ID != "new-member" {
+		t.Errorf("expected the new membership to be tracked, got %q", newState.ID)
+	}
+}
+
+func TestAccountMemberEmailCaseChangeIsIgnored(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "member",
+		Attributes: map[string]string{
+			"id":                  "member",
+			"email_address":       "user@example.com",
+			"role_ids.#":          "1",
+			"role_ids.2490201224": "05784afa30c1afe1440e79d9351c7430",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"email_address": "User@Example.com",
+		"role_ids":      []interface{}{"05784afa30c1afe1440e79d9351c7430"},
+	})
+
+	diff, err := resourceCloudflareAccountMember().Diff(context.Background(), state, config, nil)
+	if err != nil {
+		t.Fatalf("unexpected error planning: %s", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no changes for a differently cased email address, got %v", diff.Attributes)
+	}
+}
@@ -21,7 +21,8 @@ func resourceCloudflareFilter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareFilterImport,
 		},
-		Description: "Filter expressions that can be referenced across multiple features, e.g. Firewall Rules. See [what is a filter](https://developers.cloudflare.com/firewall/api/cf-filters/what-is-a-filter/) for more details and available fields and operators.",
+		CustomizeDiff: resourceCloudflareFilterValidateListReferences,
+		Description:   "Filter expressions that can be referenced across multiple features, e.g. Firewall Rules. See [what is a filter](https://developers.cloudflare.com/firewall/api/cf-filters/what-is-a-filter/) for more details and available fields and operators.",
 	}
 }
 
@@ -163,9 +164,20 @@ func resourceCloudflareFilterImport(ctx context.Context, d *schema.ResourceData,
 	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Filter: id %s for zone %s", filterID, zoneID))
 
 	d.Set("zone_id", zoneID)
+	d.Set("validate_list_references", false)
 	d.SetId(filterID)
 
 	resourceCloudflareFilterRead(ctx, d, meta)
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func resourceCloudflareFilterValidateListReferences(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate_list_references").(bool) || !d.NewValueKnown("expression") || !d.NewValueKnown("zone_id") {
+		return nil
+	}
+
+	client := meta.(*cloudflare.API)
+
+	return validateExpressionListReferences(ctx, client, "", d.Get("zone_id").(string), []string{d.Get("expression").(string)})
+}
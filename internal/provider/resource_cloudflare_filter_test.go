@@ -3,12 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func init() {
@@ -79,6 +82,53 @@ func testFilterConfig(resourceID, zoneID, paused, description, expression string
 		`, resourceID, zoneID, paused, description, expression)
 }
 
+func TestAccFilterMissingListReference(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testFilterConfigValidateListReferences(rnd, zoneID, "ip.src in $"+rnd+"_missing"),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`expression references lists that do not exist in account "[a-f0-9]+": \$%s_missing`, rnd)),
+			},
+		},
+	})
+}
+
+func TestFilterValidateListReferencesSkipsUnknownZone(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request to %s while the zone is unknown", r.Method, r.URL.Path)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	// Terraform's marker for values that are not known until apply.
+	unknown := "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	_, err := resourceCloudflareFilter().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id":                  unknown,
+		"expression":               "ip.src in $office_ips",
+		"validate_list_references": true,
+	}), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func testFilterConfigValidateListReferences(resourceID, zoneID, expression string) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_filter" "%[1]s" {
+		  zone_id = "%[2]s"
+		  expression = "%[3]s"
+		  validate_list_references = true
+		}
+		`, resourceID, zoneID, expression)
+}
+
 const multiLineFilter = `
 resource "cloudflare_filter" "%[1]s" {
 	zone_id = "%[2]s"
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"html"
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -112,6 +113,8 @@ func resourceCloudflareFirewallRuleRead(ctx context.Context, d *schema.ResourceD
 	d.Set("priority", firewallRule.Priority)
 	d.Set("filter_id", firewallRule.Filter.ID)
 	d.Set("products", products)
+	d.Set("created_on", flattenTimestampRFC3339(firewallRule.CreatedOn))
+	d.Set("modified_on", flattenTimestampRFC3339(firewallRule.ModifiedOn))
 
 	return nil
 }
@@ -184,11 +187,19 @@ func resourceCloudflareFirewallRuleImport(ctx context.Context, d *schema.Resourc
 	idAttr := strings.SplitN(d.Id(), "/", 2)
 
 	if len(idAttr) != 2 {
-		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/ruleID\"", d.Id())
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/ruleID\" or \"zoneID/description=<description>\"", d.Id())
 	}
 
 	zoneID, ruleID := idAttr[0], idAttr[1]
 
+	if description := strings.TrimPrefix(ruleID, "description="); description != ruleID {
+		var err error
+		ruleID, err = firewallRuleIDByDescription(ctx, meta.(*cloudflare.API), zoneID, description)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Firewall Rule: id %s for zone %s", ruleID, zoneID))
 
 	d.Set("zone_id", zoneID)
@@ -198,3 +209,37 @@ func resourceCloudflareFirewallRuleImport(ctx context.Context, d *schema.Resourc
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// firewallRuleIDByDescription resolves the ID of the single firewall rule in
+// the zone whose description matches exactly.
+func firewallRuleIDByDescription(ctx context.Context, client *cloudflare.API, zoneID, description string) (string, error) {
+	var matches []string
+	pageOpts := cloudflare.PaginationOptions{Page: 1, PerPage: 100}
+
+	for {
+		rules, err := client.FirewallRules(ctx, zoneID, pageOpts)
+		if err != nil {
+			return "", fmt.Errorf("error listing Firewall Rules for zone %q: %w", zoneID, err)
+		}
+
+		for _, rule := range rules {
+			if html.UnescapeString(rule.Description) == description {
+				matches = append(matches, rule.ID)
+			}
+		}
+
+		if len(rules) < pageOpts.PerPage {
+			break
+		}
+		pageOpts.Page++
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no Firewall Rule found in zone %q with description %q", zoneID, description)
+	case 1:
+		return matches[0], nil
+	default:
+		return "", fmt.Errorf("multiple Firewall Rules found in zone %q with description %q, import one of them by ID instead: %s", zoneID, description, strings.Join(matches, ", "))
+	}
+}
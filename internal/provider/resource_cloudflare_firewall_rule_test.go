@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -179,3 +181,87 @@ func testAccFirewallRuleWithUnicodeAndHTMLEntityConfig(resourceID, zoneID, pause
 		}
 		`, resourceID, zoneID, paused, description, expression, action, priority)
 }
+
+func TestFirewallRuleReadTimestamps(t *testing.T) {
+	testCases := map[string]struct {
+		timestamps         string
+		expectedCreatedOn  string
+		expectedModifiedOn string
+	}{
+		"timestamps returned": {
+			timestamps:         `, "created_on": "2022-06-01T10:21:14.123456Z", "modified_on": "2022-06-14T08:02:55Z"`,
+			expectedCreatedOn:  "2022-06-01T10:21:14Z",
+			expectedModifiedOn: "2022-06-14T08:02:55Z",
+		},
+		"timestamps omitted": {},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/firewall/rules/f2d427378e7542acb295380d352e2ebd", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("content-type", "application/json")
+				fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "f2d427378e7542acb295380d352e2ebd", "paused": false, "description": "", "action": "block", "filter": {"id": "372e67954025e0ba6aaa6d586b9e0b61"}%s}}`, tc.timestamps)
+			})
+
+			client := newTestAPIClient(t, mux)
+
+			d := resourceCloudflareFirewallRule().TestResourceData()
+			d.SetId("f2d427378e7542acb295380d352e2ebd")
+			d.Set("zone_id", testAccCloudflareZoneID)
+
+			if diags := resourceCloudflareFirewallRuleRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error reading firewall rule: %v", diags)
+			}
+
+			if got := d.Get("created_on").(string); got != tc.expectedCreatedOn {
+				t.Errorf("expected created_on %q, got %q", tc.expectedCreatedOn, got)
+			}
+
+			if got := d.Get("modified_on").(string); got != tc.expectedModifiedOn {
+				t.Errorf("expected modified_on %q, got %q", tc.expectedModifiedOn, got)
+			}
+		})
+	}
+}
+
+func TestFirewallRuleIDByDescription(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/firewall/rules", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+			{"id": "f2d427378e7542acb295380d352e2ebd", "description": "block bad bots", "action": "block", "filter": {"id": "372e67954025e0ba6aaa6d586b9e0b61"}},
+			{"id": "2ae338944d6143378c3cf05a7c77d983", "description": "allow office &amp; VPN", "action": "allow", "filter": {"id": "8f65a4a1e9b24d1d86e2a0e4a3b7d2c8"}},
+			{"id": "9b4c8a5e0f2d4e6f8a1b3c5d7e9f0a2b", "description": "duplicate", "action": "block", "filter": {"id": "4d2b1c7e8f9a4b6c8d0e2f4a6b8c0d1e"}},
+			{"id": "1c3e5a7b9d0f4e2a8c6b4d2f0e8a6c4b", "description": "duplicate", "action": "log", "filter": {"id": "6e8a0c2b4d6f4e8a0c2b4d6f8e0a2c4b"}}
+		]}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	ctx := context.Background()
+
+	id, err := firewallRuleIDByDescription(ctx, client, testAccCloudflareZoneID, "block bad bots")
+	if err != nil || id != "f2d427378e7542acb295380d352e2ebd" {
+		t.Errorf("expected rule f2d427378e7542acb295380d352e2ebd, got %q (err: %v)", id, err)
+	}
+
+	id, err = firewallRuleIDByDescription(ctx, client, testAccCloudflareZoneID, "allow office & VPN")
+	if err != nil || id != "2ae338944d6143378c3cf05a7c77d983" {
+		t.Errorf("expected rule 2ae338944d6143378c3cf05a7c77d983, got %q (err: %v)", id, err)
+	}
+
+	if _, err = firewallRuleIDByDescription(ctx, client, testAccCloudflareZoneID, "block"); err == nil {
+		t.Error("expected an error when no rule matches the description")
+	}
+
+	_, err = firewallRuleIDByDescription(ctx, client, testAccCloudflareZoneID, "duplicate")
+	if err == nil {
+		t.Fatal("expected an error when multiple rules match the description")
+	}
+	for _, candidate := range []string{"9b4c8a5e0f2d4e6f8a1b3c5d7e9f0a2b", "1c3e5a7b9d0f4e2a8c6b4d2f0e8a6c4b"} {
+		if !strings.Contains(err.Error(), candidate) {
+			t.Errorf("expected error to list candidate rule %s, got: %s", candidate, err)
+		}
+	}
+}
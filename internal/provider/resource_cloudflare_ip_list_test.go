@@ -147,6 +147,47 @@ func TestAccCloudflareIPList_Update(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareIPList_ItemComment(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the IP List
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_ip_list.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	var IPList cloudflare.IPList
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareIPListItemComment(rnd, accountID, "office egress"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareIPListExists(name, &IPList),
+					resource.TestCheckResourceAttr(name, "item.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "item.*", map[string]string{
+						"value":   "192.0.2.0",
+						"comment": "office egress",
+					}),
+				),
+			},
+			{
+				// Surrounding whitespace is trimmed by the API and must not
+				// result in a perpetual diff.
+				Config:   testAccCheckCloudflareIPListItemComment(rnd, accountID, " office egress "),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckCloudflareIPListExists(n string, list *cloudflare.IPList) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
@@ -200,3 +241,17 @@ func testAccCheckCloudflareIPListUpdate(ID, name, description, accountID string)
     }
   }`, ID, name, description, accountID)
 }
+
+func testAccCheckCloudflareIPListItemComment(ID, accountID, comment string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ip_list" "%[1]s" {
+    account_id = "%[2]s"
+    name = "%[1]s"
+    kind = "ip"
+
+    item {
+      value = "192.0.2.0"
+      comment = "%[3]s"
+    }
+  }`, ID, accountID, comment)
+}
@@ -2,12 +2,18 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -22,10 +28,151 @@ func resourceCloudflareList() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareListImport,
 		},
-		Description: "Provides Lists (IPs, Redirects) to be used in Edge Rules Engine across all zones within the same account.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: resourceCloudflareListValidateHostnameItems,
+		Description:   "Provides Lists (IPs, Redirects, Hostnames) to be used in Edge Rules Engine across all zones within the same account.",
 	}
 }
 
+// listItemCreateRequest extends cloudflare.ListItemCreateRequest with the
+// hostname items cloudflare-go doesn't support yet.
+type listItemCreateRequest struct {
+	cloudflare.ListItemCreateRequest
+	Hostname *listItemHostname `json:"hostname,omitempty"`
+}
+
+// listItem extends cloudflare.ListItem with the hostname items cloudflare-go
+// doesn't support yet.
+type listItem struct {
+	cloudflare.ListItem
+	Hostname *listItemHostname `json:"hostname,omitempty"`
+}
+
+type listItemHostname struct {
+	URLHostname string `json:"url_hostname"`
+
+	// only applies to wildcard hostnames, when set the hostname itself isn't
+	// matched, only its subdomains.
+	ExcludeExplodedHostnames *bool `json:"exclude_exploded_hostnames,omitempty"`
+}
+
+type listItemsOperation struct {
+	OperationID string `json:"operation_id"`
+}
+
+func listItemsURI(accountID, listID string) string {
+	return fmt.Sprintf("/accounts/%s/rules/lists/%s/items", accountID, listID)
+}
+
+// readListItems returns every item of a list. cloudflare-go drops the value
+// of hostname items, so the items of hostname lists are decoded from the
+// recorded responses of the paginated items request.
+func readListItems(ctx context.Context, client *cloudflare.API, accountID, listID, kind string) ([]listItem, error) {
+	var items []listItem
+
+	if kind != "hostname" {
+		listItems, err := client.ListListItems(ctx, cloudflare.ListListItemsParams{
+			AccountID: accountID,
+			ID:        listID,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range listItems {
+			items = append(items, listItem{ListItem: i})
+		}
+
+		return items, nil
+	}
+
+	ctx, recorder := withRawResponseRecorder(ctx)
+	listItems, err := client.ListListItems(ctx, cloudflare.ListListItemsParams{
+		AccountID: accountID,
+		ID:        listID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, body := range recorder.bodies {
+		var page struct {
+			Result []listItem `json:"result"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("error unmarshalling List Items: %w", err)
+		}
+
+		items = append(items, page.Result...)
+	}
+
+	if len(items) != len(listItems) {
+		return nil, fmt.Errorf("error reading hostname List Items: the API client did not record the responses")
+	}
+
+	return items, nil
+}
+
+// writeHostnameListItems adds (POST) or replaces (PUT) the items of a hostname
+// list, which cloudflare-go doesn't support yet, and waits for the
+// asynchronous operation to complete.
+func writeHostnameListItems(ctx context.Context, client *cloudflare.API, method, accountID, listID string, items []listItemCreateRequest, timeout time.Duration) error {
+	var operation listItemsOperation
+	if err := rawAPIRequest(ctx, client, method, listItemsURI(accountID, listID), items, &operation); err != nil {
+		return err
+	}
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		bulkOperation, err := client.GetListBulkOperation(ctx, cloudflare.ListGetBulkOperationParams{
+			AccountID: accountID,
+			ID:        operation.OperationID,
+		})
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error reading List bulk operation %q: %w", operation.OperationID, err))
+		}
+
+		switch bulkOperation.Status {
+		case "completed":
+			return nil
+		case "pending", "running":
+			tflog.Debug(ctx, fmt.Sprintf("List bulk operation %s is %s, waiting", operation.OperationID, bulkOperation.Status))
+			return resource.RetryableError(fmt.Errorf("waiting for List bulk operation %q to complete", operation.OperationID))
+		case "failed":
+			return resource.NonRetryableError(fmt.Errorf("list bulk operation %q failed: %s", operation.OperationID, bulkOperation.Error))
+		default:
+			return resource.NonRetryableError(fmt.Errorf("list bulk operation %q has unexpected status %q", operation.OperationID, bulkOperation.Status))
+		}
+	})
+}
+
+// resourceCloudflareListValidateHostnameItems checks that only wildcard
+// hostnames exclude the hostname itself.
+func resourceCloudflareListValidateHostnameItems(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for i, item := range d.Get("item").([]interface{}) {
+		if item == nil {
+			continue
+		}
+		values := item.(map[string]interface{})["value"].([]interface{})
+		if len(values) == 0 || values[0] == nil {
+			continue
+		}
+		hostnames := values[0].(map[string]interface{})["hostname"].([]interface{})
+		if len(hostnames) == 0 || hostnames[0] == nil {
+			continue
+		}
+
+		hostname := hostnames[0].(map[string]interface{})["url_hostname"].(string)
+		if _, ok := d.GetOkExists(fmt.Sprintf("item.%d.value.0.hostname.0.exclude_exploded_hostnames", i)); ok && !strings.HasPrefix(hostname, "*.") {
+			return fmt.Errorf("item %d: exclude_exploded_hostnames can only be set for wildcard hostnames starting with \"*.\", got %q", i, hostname)
+		}
+	}
+
+	return nil
+}
+
 func resourceCloudflareListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
@@ -44,11 +191,15 @@ func resourceCloudflareListCreate(ctx context.Context, d *schema.ResourceData, m
 
 	if items, ok := d.GetOk("item"); ok {
 		items := buildListItemsCreateRequest(d, items.([]interface{}))
-		_, err = client.CreateListItems(ctx, cloudflare.ListCreateItemsParams{
-			AccountID: accountID,
-			ID:        d.Id(),
-			Items:     items,
-		})
+		if d.Get("kind").(string) == "hostname" {
+			err = writeHostnameListItems(ctx, client, http.MethodPost, accountID, d.Id(), items, d.Timeout(schema.TimeoutCreate))
+		} else {
+			_, err = client.CreateListItems(ctx, cloudflare.ListCreateItemsParams{
+				AccountID: accountID,
+				ID:        d.Id(),
+				Items:     listItemCreateRequests(items),
+			})
+		}
 		if err != nil {
 			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
 		}
@@ -94,10 +245,7 @@ func resourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("description", list.Description)
 	d.Set("kind", list.Kind)
 
-	items, err := client.ListListItems(ctx, cloudflare.ListListItemsParams{
-		AccountID: accountID,
-		ID:        d.Id(),
-	})
+	items, err := readListItems(ctx, client, accountID, d.Id(), list.Kind)
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading List Items")))
 	}
@@ -124,6 +272,12 @@ func resourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, met
 				"preserve_path_suffix":  i.Redirect.PreservePathSuffix,
 			}}
 		}
+		if hostname := i.Hostname; hostname != nil {
+			value["hostname"] = []map[string]interface{}{{
+				"url_hostname":               hostname.URLHostname,
+				"exclude_exploded_hostnames": hostname.ExcludeExplodedHostnames != nil && *hostname.ExcludeExplodedHostnames,
+			}}
+		}
 
 		item["value"] = []map[string]interface{}{value}
 		item["comment"] = i.Comment
@@ -151,11 +305,15 @@ func resourceCloudflareListUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	if items, ok := d.GetOk("item"); ok {
 		items := buildListItemsCreateRequest(d, items.([]interface{}))
-		_, err = client.ReplaceListItems(ctx, cloudflare.ListReplaceItemsParams{
-			AccountID: accountID,
-			ID:        d.Id(),
-			Items:     items,
-		})
+		if d.Get("kind").(string) == "hostname" {
+			err = writeHostnameListItems(ctx, client, http.MethodPut, accountID, d.Id(), items, d.Timeout(schema.TimeoutUpdate))
+		} else {
+			_, err = client.ReplaceListItems(ctx, cloudflare.ListReplaceItemsParams{
+				AccountID: accountID,
+				ID:        d.Id(),
+				Items:     listItemCreateRequests(items),
+			})
+		}
 		if err != nil {
 			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
 		}
@@ -179,15 +337,15 @@ func resourceCloudflareListDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func buildListItemsCreateRequest(resource *schema.ResourceData, items []interface{}) []cloudflare.ListItemCreateRequest {
-	var listItems []cloudflare.ListItemCreateRequest
+func buildListItemsCreateRequest(resource *schema.ResourceData, items []interface{}) []listItemCreateRequest {
+	var listItems []listItemCreateRequest
 
 	for i, item := range items {
 		value := item.(map[string]interface{})["value"].([]interface{})[0].(map[string]interface{})
 
 		_, hasIP := resource.GetOkExists(fmt.Sprintf("item.%d.value.0.ip", i))
 
-		var ip *string = nil
+		var ip *string
 		if hasIP {
 			maybeIP := value["ip"].(string)
 			ip = &maybeIP
@@ -195,18 +353,18 @@ func buildListItemsCreateRequest(resource *schema.ResourceData, items []interfac
 
 		_, hasRedirect := resource.GetOkExists(fmt.Sprintf("item.%d.value.0.redirect", i))
 
-		var redirect *cloudflare.Redirect = nil
+		var redirect *cloudflare.Redirect
 		if hasRedirect {
 			r := value["redirect"].([]interface{})[0].(map[string]interface{})
 
 			sourceUrl := r["source_url"].(string)
 			targetUrl := r["target_url"].(string)
 
-			var includeSubdomains *bool = nil
-			var subpathMatching *bool = nil
-			var statusCode *int = nil
-			var preserveQueryString *bool = nil
-			var preservePathSuffix *bool = nil
+			var includeSubdomains *bool
+			var subpathMatching *bool
+			var statusCode *int
+			var preserveQueryString *bool
+			var preservePathSuffix *bool
 
 			hasField := func(field string) bool {
 				_, has := resource.GetOkExists(fmt.Sprintf("item.%d.value.0.redirect.0.%s", i, field))
@@ -240,12 +398,110 @@ func buildListItemsCreateRequest(resource *schema.ResourceData, items []interfac
 			}
 		}
 
-		listItems = append(listItems, cloudflare.ListItemCreateRequest{
-			IP:       ip,
-			Redirect: redirect,
-			Comment:  item.(map[string]interface{})["comment"].(string),
+		var hostname *listItemHostname
+		if h, ok := value["hostname"].([]interface{}); ok && len(h) > 0 && h[0] != nil {
+			hostname = &listItemHostname{
+				URLHostname: h[0].(map[string]interface{})["url_hostname"].(string),
+			}
+			if exclude, ok := resource.GetOkExists(fmt.Sprintf("item.%d.value.0.hostname.0.exclude_exploded_hostnames", i)); ok {
+				hostname.ExcludeExplodedHostnames = cloudflare.BoolPtr(exclude.(bool))
+			}
+		}
+
+		listItems = append(listItems, listItemCreateRequest{
+			ListItemCreateRequest: cloudflare.ListItemCreateRequest{
+				IP:       ip,
+				Redirect: redirect,
+				Comment:  item.(map[string]interface{})["comment"].(string),
+			},
+			Hostname: hostname,
 		})
 	}
 
 	return listItems
 }
+
+// listItemCreateRequests returns the cloudflare-go requests for the items of
+// lists other than hostname lists.
+func listItemCreateRequests(items []listItemCreateRequest) []cloudflare.ListItemCreateRequest {
+	var requests []cloudflare.ListItemCreateRequest
+	for _, item := range items {
+		requests = append(requests, item.ListItemCreateRequest)
+	}
+
+	return requests
+}
+
+var (
+	expressionStringLiteralPattern = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+	expressionListReferencePattern = regexp.MustCompile(`\$([A-Za-z0-9_.]+)`)
+)
+
+// expressionListReferences returns the names of the custom lists referenced as
+// `$list_name` in a rules language expression. Managed lists, which are
+// prefixed with `cf.`, are ignored as they always exist.
+func expressionListReferences(expression string) []string {
+	expression = expressionStringLiteralPattern.ReplaceAllString(expression, `""`)
+
+	var names []string
+	for _, match := range expressionListReferencePattern.FindAllStringSubmatch(expression, -1) {
+		if strings.HasPrefix(match[1], "cf.") {
+			continue
+		}
+		if !contains(names, match[1]) {
+			names = append(names, match[1])
+		}
+	}
+
+	return names
+}
+
+// validateExpressionListReferences checks that every custom list referenced in
+// the expressions exists in the account. When the account isn't known it is
+// looked up from the zone.
+func validateExpressionListReferences(ctx context.Context, client *cloudflare.API, accountID, zoneID string, expressions []string) error {
+	var references []string
+	for _, expression := range expressions {
+		for _, name := range expressionListReferences(expression) {
+			if !contains(references, name) {
+				references = append(references, name)
+			}
+		}
+	}
+
+	if len(references) == 0 {
+		return nil
+	}
+
+	if accountID == "" {
+		zone, err := client.ZoneDetails(ctx, zoneID)
+		if err != nil {
+			return fmt.Errorf("error finding Zone %q to validate list references: %w", zoneID, err)
+		}
+		accountID = zone.Account.ID
+	}
+
+	lists, err := client.ListLists(ctx, cloudflare.ListListsParams{AccountID: accountID})
+	if err != nil {
+		return fmt.Errorf("error listing Lists for account %q to validate list references: %w", accountID, err)
+	}
+
+	existing := make(map[string]bool, len(lists))
+	for _, list := range lists {
+		existing[list.Name] = true
+	}
+
+	var missing []string
+	for _, name := range references {
+		if !existing[name] {
+			missing = append(missing, "$"+name)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("expression references lists that do not exist in account %q: %s", accountID, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
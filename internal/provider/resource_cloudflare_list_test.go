@@ -3,11 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -178,6 +183,48 @@ func TestAccCloudflareList_Update(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareList_HostnameExcludeExplodedHostnames(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the IP List
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_list.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	var list cloudflare.List
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareListHostname(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareListExists(name, &list),
+					resource.TestCheckResourceAttr(name, "kind", "hostname"),
+					resource.TestCheckResourceAttr(name, "item.#", "2"),
+					resource.TestCheckResourceAttr(name, "item.0.value.0.hostname.0.url_hostname", "*.example.com"),
+					resource.TestCheckResourceAttr(name, "item.0.value.0.hostname.0.exclude_exploded_hostnames", "true"),
+					resource.TestCheckResourceAttr(name, "item.1.value.0.hostname.0.url_hostname", "example.net"),
+				),
+			},
+			{
+				Config:      strings.Replace(testAccCheckCloudflareListHostname(rnd, accountID), `"example.net"`, `"https://example.net/"`, 1),
+				ExpectError: regexp.MustCompile(`must be a hostname without a scheme, port or path`),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudflareListExists(n string, list *cloudflare.List) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
@@ -273,3 +320,196 @@ func testAccCheckCloudflareListRedirectUpdate(ID, name, description, accountID s
     }
   }`, ID, name, description, accountID)
 }
+
+func testAccCheckCloudflareListHostname(name, accountID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_list" "%[1]s" {
+    account_id  = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s"
+    kind        = "hostname"
+
+    item {
+      value {
+        hostname {
+          url_hostname               = "*.example.com"
+          exclude_exploded_hostnames = true
+        }
+      }
+      comment = "subdomains only"
+    }
+
+    item {
+      value {
+        hostname {
+          url_hostname = "example.net"
+        }
+      }
+    }
+  }`, name, accountID)
+}
+
+func TestValidateListItemHostname(t *testing.T) {
+	testCases := map[string]bool{
+		"example.com":           true,
+		"*.example.com":         true,
+		"api.eu.example.co.uk":  true,
+		"xn--bcher-kva.example": true,
+		"Example.com":           false,
+		"https://example.com":   false,
+		"example.com/blog":      false,
+		"example.com:8443":      false,
+		"*example.com":          false,
+		"api.*.example.com":     false,
+		"-example.com":          false,
+		"example":               false,
+		"exa_mple.com":          false,
+	}
+
+	for hostname, valid := range testCases {
+		t.Run(hostname, func(t *testing.T) {
+			_, errs := validateListItemHostname(hostname, "url_hostname")
+			if valid && len(errs) > 0 {
+				t.Errorf("expected %q to be valid, got %v", hostname, errs)
+			}
+			if !valid && len(errs) == 0 {
+				t.Errorf("expected %q to be invalid", hostname)
+			}
+		})
+	}
+}
+
+func TestListHostnameItemsRoundTrip(t *testing.T) {
+	mux := http.NewServeMux()
+	listID := "2c0fc9fa937b11eaa1b71c4d701ab86e"
+	listURI := "/accounts/" + testAccCloudflareAccountID + "/rules/lists/" + listID
+
+	var written []map[string]interface{}
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/rules/lists", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "hosts", "kind": "hostname"}}`, listID)
+	})
+	mux.HandleFunc(listURI, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "hosts", "kind": "hostname"}}`, listID)
+	})
+	mux.HandleFunc(listURI+"/items", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodPost {
+			if !decodeTestRequestBody(t, w, r, &written) {
+				return
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"operation_id": "4da8780eeb215e6cb7f48dd981c4ea02"}}`)
+			return
+		}
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+				{"id": "7c5dae5552338874e5053f2534d2767a", "comment": "subdomains only", "hostname": {"url_hostname": "*.example.com", "exclude_exploded_hostnames": true}}
+			], "result_info": {"cursors": {"after": "yyy"}}}`)
+			return
+		}
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+			{"id": "34b12448945f11eaa1b71c4d701ab86e", "hostname": {"url_hostname": "example.net"}}
+		], "result_info": {"cursors": {"before": "yyy"}}}`)
+	})
+	mux.HandleFunc(listURI+"/items/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request for a single List Item %s", r.URL.Path)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/rules/lists/bulk_operations/4da8780eeb215e6cb7f48dd981c4ea02", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "4da8780eeb215e6cb7f48dd981c4ea02", "status": "completed"}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareListSchema(), map[string]interface{}{
+		"account_id": testAccCloudflareAccountID,
+		"name":       "hosts",
+		"kind":       "hostname",
+		"item": []interface{}{map[string]interface{}{
+			"value": []interface{}{map[string]interface{}{
+				"hostname": []interface{}{map[string]interface{}{
+					"url_hostname":               "*.example.com",
+					"exclude_exploded_hostnames": true,
+				}},
+			}},
+			"comment": "subdomains only",
+		}},
+	})
+
+	if diags := resourceCloudflareListCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := []map[string]interface{}{{
+		"comment":  "subdomains only",
+		"hostname": map[string]interface{}{"url_hostname": "*.example.com", "exclude_exploded_hostnames": true},
+	}}
+	if !reflect.DeepEqual(written, expected) {
+		t.Errorf("expected items %v to be written, got %v", expected, written)
+	}
+
+	if got := d.Get("item.0.value.0.hostname.0.url_hostname").(string); got != "*.example.com" {
+		t.Errorf("expected the hostname to be read back, got %q", got)
+	}
+	if !d.Get("item.0.value.0.hostname.0.exclude_exploded_hostnames").(bool) {
+		t.Error("expected exclude_exploded_hostnames to be read back")
+	}
+	if got := d.Get("item.1.value.0.hostname.0.url_hostname").(string); got != "example.net" {
+		t.Errorf("expected the hostname on the second page to be read back, got %q", got)
+	}
+}
+
+func TestExpressionListReferences(t *testing.T) {
+	testCases := map[string]struct {
+		expression string
+		expected   []string
+	}{
+		"single list":                 {`ip.src in $office_ips`, []string{"office_ips"}},
+		"repeated and multiple lists": {`(ip.src in $office_ips and not ip.src in $blocked) or ip.src in $office_ips`, []string{"office_ips", "blocked"}},
+		"managed lists are ignored":   {`ip.src in $cf.open_proxies`, nil},
+		"dollar signs in strings":     {`http.request.uri.path eq "/pay/$invoice" and ip.src in $partners`, []string{"partners"}},
+		"no lists":                    {`http.host eq "example.com"`, nil},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := expressionListReferences(tc.expression); !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateExpressionListReferences(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "example.com", "account": {"id": "%s"}}}`, testAccCloudflareZoneID, testAccCloudflareAccountID)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/rules/lists", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [
+			{"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "name": "office_ips", "kind": "ip"}
+		]}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	err := validateExpressionListReferences(context.Background(), client, "", testAccCloudflareZoneID, []string{
+		`ip.src in $office_ips`,
+		`ip.src in $vpn_ips or ip.src in $blocked_ips`,
+	})
+	if err == nil {
+		t.Fatal("expected an error for the missing list references")
+	}
+
+	expected := fmt.Sprintf(`expression references lists that do not exist in account %q: $blocked_ips, $vpn_ips`, testAccCloudflareAccountID)
+	if err.Error() != expected {
+		t.Errorf("expected error %q, got %q", expected, err.Error())
+	}
+
+	if err := validateExpressionListReferences(context.Background(), client, testAccCloudflareAccountID, "", []string{`ip.src in $office_ips`}); err != nil {
+		t.Errorf("unexpected error for existing list reference: %s", err)
+	}
+}
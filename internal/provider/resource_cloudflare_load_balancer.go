@@ -30,6 +30,8 @@ func resourceCloudflareLoadBalancer() *schema.Resource {
 
 		Schema: resourceCloudflareLoadBalancerSchema(),
 
+		CustomizeDiff: resourceCloudflareLoadBalancerValidateZeroDowntimeFailover,
+
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Type:    resourceCloudflareLoadBalancerV0().CoreConfigSchema().ImpliedType(),
@@ -40,6 +42,64 @@ func resourceCloudflareLoadBalancer() *schema.Resource {
 	}
 }
 
+// zeroDowntimeFailoverValues are the supported values of the
+// `zero_downtime_failover` session affinity attribute.
+var zeroDowntimeFailoverValues = []string{"none", "temporary", "sticky"}
+
+// resourceCloudflareLoadBalancerValidateZeroDowntimeFailover ensures
+// `zero_downtime_failover` is only used together with session affinity, both
+// on the load balancer and on the overrides of its rules. Overrides without
+// their own `session_affinity` inherit the one of the load balancer.
+func resourceCloudflareLoadBalancerValidateZeroDowntimeFailover(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	sessionAffinity := d.Get("session_affinity").(string)
+
+	attrs := d.Get("session_affinity_attributes").(map[string]interface{})
+	if err := validateZeroDowntimeFailover("session_affinity_attributes", attrs, sessionAffinity); err != nil {
+		return err
+	}
+
+	for i, rule := range d.Get("rules").([]interface{}) {
+		if rule == nil {
+			continue
+		}
+		for _, override := range rule.(map[string]interface{})["overrides"].([]interface{}) {
+			if override == nil {
+				continue
+			}
+			o := override.(map[string]interface{})
+
+			overrideSessionAffinity := sessionAffinity
+			if sa := o["session_affinity"].(string); sa != "" {
+				overrideSessionAffinity = sa
+			}
+
+			attrs := o["session_affinity_attributes"].(map[string]interface{})
+			if err := validateZeroDowntimeFailover(fmt.Sprintf("rules.%d.overrides.0.session_affinity_attributes", i), attrs, overrideSessionAffinity); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateZeroDowntimeFailover(key string, attrs map[string]interface{}, sessionAffinity string) error {
+	value, ok := attrs["zero_downtime_failover"]
+	if !ok {
+		return nil
+	}
+
+	if !contains(zeroDowntimeFailoverValues, value.(string)) {
+		return fmt.Errorf("expected %s.zero_downtime_failover to be one of %v, got %s", key, zeroDowntimeFailoverValues, value)
+	}
+
+	if sessionAffinity == "" || sessionAffinity == "none" {
+		return fmt.Errorf("%s.zero_downtime_failover can only be set when session affinity is enabled", key)
+	}
+
+	return nil
+}
+
 var rulesElem = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"name": {
@@ -404,7 +464,11 @@ func resourceCloudflareLoadBalancerRead(ctx context.Context, d *schema.ResourceD
 	d.Set("modified_on", loadBalancer.ModifiedOn.Format(time.RFC3339Nano))
 
 	if _, sessionAffinityAttrsOk := d.GetOk("session_affinity_attributes"); sessionAffinityAttrsOk {
-		if err := d.Set("session_affinity_attributes", flattenSessionAffinityAttrs(loadBalancer.SessionAffinityAttributes)); err != nil {
+		sessionAffinityAttrs := flattenSessionAffinityAttrs(loadBalancer.SessionAffinityAttributes)
+		if _, ok := d.GetOk("session_affinity_attributes.zero_downtime_failover"); ok {
+			sessionAffinityAttrs["zero_downtime_failover"] = loadBalancer.SessionAffinityAttributes.ZeroDowntimeFailover
+		}
+		if err := d.Set("session_affinity_attributes", sessionAffinityAttrs); err != nil {
 			return diag.FromErr(fmt.Errorf("failed to set session_affinity_attributes: %w", err))
 		}
 	}
@@ -579,6 +643,9 @@ func flattenRules(d *schema.ResourceData, rules []*cloudflare.LoadBalancerRule)
 				if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.session_affinity_attributes.secure", idx)); ok {
 					saa["secure"] = o.SessionAffinityAttrs.Secure
 				}
+				if _, ok := d.GetOkExists(fmt.Sprintf("rules.%d.overrides.0.session_affinity_attributes.zero_downtime_failover", idx)); ok {
+					saa["zero_downtime_failover"] = o.SessionAffinityAttrs.ZeroDowntimeFailover
+				}
 			}
 		}
 
@@ -635,6 +702,10 @@ func expandRules(rdata interface{}) ([]*cloudflare.LoadBalancerRule, error) {
 					v.Secure = sec.(string)
 					lbr.Overrides.SessionAffinityAttrs = v
 				}
+				if zdf, ok := attr["zero_downtime_failover"]; ok {
+					v.ZeroDowntimeFailover = zdf.(string)
+					lbr.Overrides.SessionAffinityAttrs = v
+				}
 			}
 
 			if ttl, ok := ov["ttl"]; ok {
@@ -708,6 +779,8 @@ func expandSessionAffinityAttrs(attrs interface{}) (*cloudflare.SessionAffinityA
 			cfSessionAffinityAttrs.Secure = v.(string)
 		case "samesite":
 			cfSessionAffinityAttrs.SameSite = v.(string)
+		case "zero_downtime_failover":
+			cfSessionAffinityAttrs.ZeroDowntimeFailover = v.(string)
 		case "drain_duration":
 			var err error
 			if cfSessionAffinityAttrs.DrainDuration, err = strconv.Atoi(v.(string)); err != nil {
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"strings"
+	"net/http"
 
 	"time"
 
@@ -25,9 +25,46 @@ func resourceCloudflareLoadBalancerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceCloudflareLoadBalancerPoolValidateMonitor,
 	}
 }
 
+// loadBalancerOrigin holds the time an origin was disabled, which
+// cloudflare-go doesn't expose yet.
+type loadBalancerOrigin struct {
+	Name       string     `json:"name"`
+	DisabledAt *time.Time `json:"disabled_at,omitempty"`
+}
+
+// loadBalancerPoolNotificationFilter filters the health status changes of
+// the pool and its origins that notifications are sent for.
+type loadBalancerPoolNotificationFilter struct {
+	Origin *loadBalancerPoolNotificationFilterTarget `json:"origin,omitempty"`
+	Pool   *loadBalancerPoolNotificationFilterTarget `json:"pool,omitempty"`
+}
+
+type loadBalancerPoolNotificationFilterTarget struct {
+	Disable bool `json:"disable"`
+	// Healthy limits notifications to changes to a healthy (true) or
+	// unhealthy (false) status. Notifications are sent for both if unset.
+	Healthy *bool `json:"healthy"`
+}
+
+// loadBalancerPoolDetails holds the fields of a load balancer pool that
+// cloudflare-go doesn't expose yet. They are read and written with raw API
+// requests alongside the cloudflare-go pool.
+type loadBalancerPoolDetails struct {
+	Origins            []loadBalancerOrigin                `json:"origins,omitempty"`
+	NotificationFilter *loadBalancerPoolNotificationFilter `json:"notification_filter"`
+}
+
+func loadBalancerPoolURI(client *cloudflare.API, poolID string) string {
+	if client.AccountID != "" {
+		return fmt.Sprintf("/accounts/%s/load_balancers/pools/%s", client.AccountID, poolID)
+	}
+	return fmt.Sprintf("/user/load_balancers/pools/%s", poolID)
+}
+
 func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -84,6 +121,12 @@ func resourceCloudflareLoadBalancerPoolCreate(ctx context.Context, d *schema.Res
 
 	d.SetId(r.ID)
 
+	if filter := expandLoadBalancerPoolNotificationFilter(d); filter != nil {
+		if err := updateLoadBalancerPoolNotificationFilter(ctx, client, d.Id(), filter); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error setting load balancer pool notification filter"))
+		}
+	}
+
 	tflog.Info(ctx, fmt.Sprintf("New Cloudflare Load Balancer Pool created with  ID: %s", d.Id()))
 
 	return resourceCloudflareLoadBalancerPoolRead(ctx, d, meta)
@@ -140,6 +183,14 @@ func resourceCloudflareLoadBalancerPoolUpdate(ctx context.Context, d *schema.Res
 		return diag.FromErr(errors.Wrap(err, "error updating load balancer pool"))
 	}
 
+	// Replacing the pool doesn't carry over the notification filter, which
+	// cloudflare-go doesn't support yet, so it's set again whenever configured.
+	if filter := expandLoadBalancerPoolNotificationFilter(d); filter != nil || d.HasChange("notification_filter") {
+		if err := updateLoadBalancerPoolNotificationFilter(ctx, client, d.Id(), filter); err != nil {
+			return diag.FromErr(errors.Wrap(err, "error updating load balancer pool notification filter"))
+		}
+	}
+
 	return resourceCloudflareLoadBalancerPoolRead(ctx, d, meta)
 }
 
@@ -213,12 +264,50 @@ func expandLoadBalancerOrigins(originSet *schema.Set) (origins []cloudflare.Load
 	return
 }
 
+func expandLoadBalancerPoolNotificationFilter(d *schema.ResourceData) *loadBalancerPoolNotificationFilter {
+	if _, ok := d.GetOk("notification_filter"); !ok {
+		return nil
+	}
+
+	expandTarget := func(target string) *loadBalancerPoolNotificationFilterTarget {
+		key := fmt.Sprintf("notification_filter.0.%s", target)
+		if _, ok := d.GetOk(key); !ok {
+			return nil
+		}
+
+		filter := &loadBalancerPoolNotificationFilterTarget{
+			Disable: d.Get(key + ".0.disable").(bool),
+		}
+
+		// `healthy` is only sent when configured, as leaving it unset notifies
+		// for both healthy and unhealthy changes.
+		if healthy := getRawValue(key+".0.healthy", d.GetRawConfig()); !healthy.IsNull() && healthy.IsKnown() {
+			h := healthy.True()
+			filter.Healthy = &h
+		}
+
+		return filter
+	}
+
+	return &loadBalancerPoolNotificationFilter{
+		Origin: expandTarget("origin"),
+		Pool:   expandTarget("pool"),
+	}
+}
+
+// updateLoadBalancerPoolNotificationFilter sets the notification filter of
+// the pool, clearing it when filter is nil.
+func updateLoadBalancerPoolNotificationFilter(ctx context.Context, client *cloudflare.API, poolID string, filter *loadBalancerPoolNotificationFilter) error {
+	return rawAPIRequest(ctx, client, http.MethodPatch, loadBalancerPoolURI(client, poolID), loadBalancerPoolDetails{NotificationFilter: filter}, nil)
+}
+
 func resourceCloudflareLoadBalancerPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
 	loadBalancerPool, err := client.LoadBalancerPoolDetails(ctx, d.Id())
 	if err != nil {
-		if strings.Contains(err.Error(), "HTTP status 404") {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
 			tflog.Info(ctx, fmt.Sprintf("Load balancer pool %s no longer exists", d.Id()))
 			d.SetId("")
 			return nil
@@ -229,6 +318,14 @@ func resourceCloudflareLoadBalancerPoolRead(ctx context.Context, d *schema.Resou
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Read Cloudflare Load Balancer Pool from API as struct: %+v", loadBalancerPool))
 
+	// The time origins were disabled and the notification filter aren't
+	// exposed by cloudflare-go yet so they're read separately.
+	var details loadBalancerPoolDetails
+	if err := rawAPIRequest(ctx, client, http.MethodGet, loadBalancerPoolURI(client, d.Id()), nil, &details); err != nil {
+		return diag.FromErr(errors.Wrap(err,
+			fmt.Sprintf("Error reading load balancer pool details from API for resource %s ", d.Id())))
+	}
+
 	d.Set("name", loadBalancerPool.Name)
 	d.Set("enabled", loadBalancerPool.Enabled)
 	d.Set("minimum_origins", loadBalancerPool.MinimumOrigins)
@@ -247,7 +344,7 @@ func resourceCloudflareLoadBalancerPoolRead(ctx context.Context, d *schema.Resou
 		d.Set("longitude", &f)
 	}
 
-	if err := d.Set("origins", flattenLoadBalancerOrigins(d, loadBalancerPool.Origins)); err != nil {
+	if err := d.Set("origins", flattenLoadBalancerOrigins(d, loadBalancerPool.Origins, details.Origins)); err != nil {
 		tflog.Warn(ctx, fmt.Sprintf("Error setting origins on load balancer pool %q: %s", d.Id(), err))
 	}
 
@@ -263,6 +360,10 @@ func resourceCloudflareLoadBalancerPoolRead(ctx context.Context, d *schema.Resou
 		tflog.Warn(ctx, fmt.Sprintf("Error setting check_regions on load balancer pool %q: %s", d.Id(), err))
 	}
 
+	if err := d.Set("notification_filter", flattenLoadBalancerPoolNotificationFilter(details.NotificationFilter)); err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error setting notification_filter on load balancer pool %q: %s", d.Id(), err))
+	}
+
 	return nil
 }
 
@@ -287,15 +388,25 @@ func flattenLoadBalancerOriginSteering(os *cloudflare.LoadBalancerOriginSteering
 	}})
 }
 
-func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []cloudflare.LoadBalancerOrigin) *schema.Set {
+func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []cloudflare.LoadBalancerOrigin, details []loadBalancerOrigin) *schema.Set {
+	disabledAt := make(map[string]*time.Time, len(details))
+	for _, o := range details {
+		disabledAt[o.Name] = o.DisabledAt
+	}
+
 	flattened := make([]interface{}, 0)
 	for _, o := range origins {
 		cfg := map[string]interface{}{
-			"name":    o.Name,
-			"address": o.Address,
-			"enabled": o.Enabled,
-			"weight":  o.Weight,
-			"header":  flattenLoadBalancerPoolHeader(o.Header),
+			"name":        o.Name,
+			"address":     o.Address,
+			"enabled":     o.Enabled,
+			"weight":      o.Weight,
+			"header":      flattenLoadBalancerPoolHeader(o.Header),
+			"disabled_at": "",
+		}
+
+		if t := disabledAt[o.Name]; t != nil {
+			cfg["disabled_at"] = t.Format(time.RFC3339Nano)
 		}
 
 		flattened = append(flattened, cfg)
@@ -303,6 +414,60 @@ func flattenLoadBalancerOrigins(d *schema.ResourceData, origins []cloudflare.Loa
 	return schema.NewSet(schema.HashResource(originsElem), flattened)
 }
 
+func flattenLoadBalancerPoolNotificationFilter(filter *loadBalancerPoolNotificationFilter) []interface{} {
+	if filter == nil || (filter.Origin == nil && filter.Pool == nil) {
+		return nil
+	}
+
+	flattenTarget := func(target *loadBalancerPoolNotificationFilterTarget) []interface{} {
+		if target == nil {
+			return nil
+		}
+
+		cfg := map[string]interface{}{
+			"disable": target.Disable,
+		}
+		if target.Healthy != nil {
+			cfg["healthy"] = *target.Healthy
+		}
+
+		return []interface{}{cfg}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"origin": flattenTarget(filter.Origin),
+		"pool":   flattenTarget(filter.Pool),
+	}}
+}
+
+// resourceCloudflareLoadBalancerPoolValidateMonitor ensures the monitor
+// referenced by the pool exists, so a typo surfaces at plan time rather than
+// as an API error part way through an apply.
+func resourceCloudflareLoadBalancerPoolValidateMonitor(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("monitor") || !d.HasChange("monitor") {
+		return nil
+	}
+
+	monitorID := d.Get("monitor").(string)
+	if monitorID == "" {
+		return nil
+	}
+
+	return validateLoadBalancerMonitorExists(ctx, meta.(*cloudflare.API), monitorID)
+}
+
+func validateLoadBalancerMonitorExists(ctx context.Context, client *cloudflare.API, monitorID string) error {
+	if _, err := client.LoadBalancerMonitorDetails(ctx, monitorID); err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return fmt.Errorf("load balancer monitor %q referenced by `monitor` does not exist", monitorID)
+		}
+		return fmt.Errorf("error validating load balancer monitor %q: %w", monitorID, err)
+	}
+
+	return nil
+}
+
 func resourceCloudflareLoadBalancerPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"reflect"
 	"regexp"
 	"testing"
 	"time"
@@ -12,6 +14,7 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
@@ -138,6 +141,260 @@ func TestAccCloudflareLoadBalancerPool_FullySpecified(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareLoadBalancerPool_DisableOrigin(t *testing.T) {
+	t.Parallel()
+	var loadBalancerPool cloudflare.LoadBalancerPool
+	rnd := generateRandomResourceName()
+	name := "cloudflare_load_balancer_pool." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareLoadBalancerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareLoadBalancerPoolConfigTwoOrigins(rnd, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerPoolExists(name, &loadBalancerPool),
+					resource.TestCheckResourceAttr(name, "origins.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "origins.*", map[string]string{
+						"name":        "example-2",
+						"enabled":     "true",
+						"disabled_at": "",
+					}),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareLoadBalancerPoolConfigTwoOrigins(rnd, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerPoolExists(name, &loadBalancerPool),
+					resource.TestCheckResourceAttr(name, "origins.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "origins.*", map[string]string{
+						"name":    "example-1",
+						"enabled": "true",
+					}),
+					resource.TestMatchTypeSetElemNestedAttrs(name, "origins.*", map[string]*regexp.Regexp{
+						"name":        regexp.MustCompile("^example-2$"),
+						"enabled":     regexp.MustCompile("^false$"),
+						"disabled_at": regexp.MustCompile(".+"),
+					}),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareLoadBalancerPoolConfigTwoOrigins(rnd, false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestLoadBalancerPoolReadSetsOriginDisabledAt(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user/load_balancers/pools/17b5962d775c646f3f9725cbc7a53df4", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "17b5962d775c646f3f9725cbc7a53df4",
+				"name": "primary-dc-1",
+				"enabled": true,
+				"created_on": "2022-01-01T05:20:00.12345Z",
+				"modified_on": "2022-06-01T10:00:00Z",
+				"origins": [
+					{"name": "app-server-1", "address": "192.0.2.1", "enabled": true, "weight": 1},
+					{"name": "app-server-2", "address": "192.0.2.2", "enabled": false, "weight": 1, "disabled_at": "2022-06-01T10:00:00Z"}
+				]
+			}
+		}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := resourceCloudflareLoadBalancerPool().TestResourceData()
+	d.SetId("17b5962d775c646f3f9725cbc7a53df4")
+
+	if diags := resourceCloudflareLoadBalancerPoolRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	disabledAt := map[string]string{}
+	for _, origin := range d.Get("origins").(*schema.Set).List() {
+		o := origin.(map[string]interface{})
+		disabledAt[o["name"].(string)] = o["disabled_at"].(string)
+	}
+
+	expected := map[string]string{
+		"app-server-1": "",
+		"app-server-2": "2022-06-01T10:00:00Z",
+	}
+	if !reflect.DeepEqual(disabledAt, expected) {
+		t.Errorf("expected origins disabled at %v, got %v", expected, disabledAt)
+	}
+}
+
+func TestLoadBalancerPoolCreateSetsNotificationFilter(t *testing.T) {
+	var pool map[string]interface{}
+	var details loadBalancerPoolDetails
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user/load_balancers/pools", func(w http.ResponseWriter, r *http.Request) {
+		if !decodeTestRequestBody(t, w, r, &pool) {
+			return
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "17b5962d775c646f3f9725cbc7a53df4"}}`)
+	})
+	mux.HandleFunc("/user/load_balancers/pools/17b5962d775c646f3f9725cbc7a53df4", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodPatch {
+			if !decodeTestRequestBody(t, w, r, &details) {
+				return
+			}
+		}
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+			"id": "17b5962d775c646f3f9725cbc7a53df4",
+			"name": "primary-dc-1",
+			"enabled": true,
+			"created_on": "2022-01-01T05:20:00.12345Z",
+			"modified_on": "2022-06-01T10:00:00Z",
+			"origins": [{"name": "app-server-1", "address": "192.0.2.1", "enabled": true, "weight": 1}],
+			"notification_filter": {"pool": {"disable": true, "healthy": null}}
+		}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareLoadBalancerPoolSchema(), map[string]interface{}{
+		"name": "primary-dc-1",
+		"origins": []interface{}{
+			map[string]interface{}{"name": "app-server-1", "address": "192.0.2.1"},
+		},
+		"notification_filter": []interface{}{map[string]interface{}{
+			"pool": []interface{}{map[string]interface{}{"disable": true}},
+		}},
+	})
+
+	if diags := resourceCloudflareLoadBalancerPoolCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if _, ok := pool["notification_filter"]; ok {
+		t.Errorf("expected the notification filter to be left out of the pool, got %v", pool)
+	}
+
+	if details.NotificationFilter == nil || details.NotificationFilter.Pool == nil || !details.NotificationFilter.Pool.Disable {
+		t.Errorf("expected the pool notifications to be disabled, got %+v", details.NotificationFilter)
+	}
+
+	if got := d.Get("notification_filter.0.pool.0.disable").(bool); !got {
+		t.Error("expected the notification filter to be read back")
+	}
+}
+
+func TestAccCloudflareLoadBalancerPool_MissingMonitor(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareLoadBalancerPoolConfigMonitor(rnd, "0123456789abcdef0123456789abcdef"),
+				ExpectError: regexp.MustCompile(`load balancer monitor "0123456789abcdef0123456789abcdef" referenced by .monitor. does not exist`),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareLoadBalancerPool_NotificationFilter(t *testing.T) {
+	t.Parallel()
+	var loadBalancerPool cloudflare.LoadBalancerPool
+	rnd := generateRandomResourceName()
+	name := "cloudflare_load_balancer_pool." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareLoadBalancerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareLoadBalancerPoolConfigNotificationFilter(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerPoolExists(name, &loadBalancerPool),
+					resource.TestCheckResourceAttr(name, "notification_email", "someone@example.com"),
+					resource.TestCheckResourceAttr(name, "notification_filter.#", "1"),
+					resource.TestCheckResourceAttr(name, "notification_filter.0.origin.0.disable", "true"),
+					resource.TestCheckResourceAttr(name, "notification_filter.0.pool.0.disable", "false"),
+					resource.TestCheckResourceAttr(name, "notification_filter.0.pool.0.healthy", "false"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareLoadBalancerPoolConfigNotificationFilter(rnd),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestValidateLoadBalancerMonitorExists(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/user/load_balancers/monitors/f1aba936b94213e5b8dca0c0dbf1f9cc", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "f1aba936b94213e5b8dca0c0dbf1f9cc", "type": "https"}
+		}`)
+	})
+	mux.HandleFunc("/user/load_balancers/monitors/0123456789abcdef0123456789abcdef", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{
+			"success": false,
+			"errors": [{"code": 1002, "message": "monitor not found"}],
+			"messages": [],
+			"result": null
+		}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	if err := validateLoadBalancerMonitorExists(context.Background(), client, "f1aba936b94213e5b8dca0c0dbf1f9cc"); err != nil {
+		t.Errorf("expected existing monitor to be valid, got %s", err)
+	}
+
+	err := validateLoadBalancerMonitorExists(context.Background(), client, "0123456789abcdef0123456789abcdef")
+	expected := "load balancer monitor \"0123456789abcdef0123456789abcdef\" referenced by `monitor` does not exist"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+}
+
+func TestFlattenLoadBalancerPoolNotificationFilter(t *testing.T) {
+	unhealthy := false
+	filter := &loadBalancerPoolNotificationFilter{
+		Origin: &loadBalancerPoolNotificationFilterTarget{Disable: true},
+		Pool:   &loadBalancerPoolNotificationFilterTarget{Healthy: &unhealthy},
+	}
+
+	expected := []interface{}{map[string]interface{}{
+		"origin": []interface{}{map[string]interface{}{"disable": true}},
+		"pool":   []interface{}{map[string]interface{}{"disable": false, "healthy": false}},
+	}}
+
+	if got := flattenLoadBalancerPoolNotificationFilter(filter); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %#v, got %#v", expected, got)
+	}
+
+	if got := flattenLoadBalancerPoolNotificationFilter(&loadBalancerPoolNotificationFilter{}); got != nil {
+		t.Errorf("expected an empty filter to be flattened to nil, got %#v", got)
+	}
+}
+
 func TestAccCloudflareLoadBalancerPool_CreateAfterManualDestroy(t *testing.T) {
 	t.Parallel()
 	var loadBalancerPool cloudflare.LoadBalancerPool
@@ -266,6 +523,60 @@ resource "cloudflare_load_balancer_pool" "%[1]s" {
 }`, id)
 }
 
+func testAccCheckCloudflareLoadBalancerPoolConfigTwoOrigins(id string, secondOriginEnabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_pool" "%[1]s" {
+  name = "my-tf-pool-two-origins-%[1]s"
+
+  origins {
+    name = "example-1"
+    address = "192.0.2.1"
+  }
+
+  origins {
+    name = "example-2"
+    address = "192.0.2.2"
+    enabled = %[2]t
+  }
+}`, id, secondOriginEnabled)
+}
+
+func testAccCheckCloudflareLoadBalancerPoolConfigMonitor(id, monitorID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_pool" "%[1]s" {
+  name = "my-tf-pool-monitor-%[1]s"
+  monitor = "%[2]s"
+
+  origins {
+    name = "example-1"
+    address = "192.0.2.1"
+  }
+}`, id, monitorID)
+}
+
+func testAccCheckCloudflareLoadBalancerPoolConfigNotificationFilter(id string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_load_balancer_pool" "%[1]s" {
+  name = "my-tf-pool-notification-filter-%[1]s"
+  notification_email = "someone@example.com"
+
+  origins {
+    name = "example-1"
+    address = "192.0.2.1"
+  }
+
+  notification_filter {
+    origin {
+      disable = true
+    }
+
+    pool {
+      healthy = false
+    }
+  }
+}`, id)
+}
+
 func testAccCheckCloudflareLoadBalancerPoolConfigFullySpecified(id string, headerValue string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_load_balancer_pool" "%[1]s" {
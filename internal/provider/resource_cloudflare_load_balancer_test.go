@@ -150,6 +150,28 @@ func TestAccCloudflareLoadBalancer_SessionAffinity(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareLoadBalancer_ZeroDowntimeFailoverValidation(t *testing.T) {
+	t.Parallel()
+	zone := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareLoadBalancerConfigZeroDowntimeFailover(zoneID, zone, rnd, "none", "temporary"),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("session_affinity_attributes.zero_downtime_failover can only be set when session affinity is enabled")),
+			},
+			{
+				Config:      testAccCheckCloudflareLoadBalancerConfigZeroDowntimeFailover(zoneID, zone, rnd, "cookie", "always"),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("expected session_affinity_attributes.zero_downtime_failover to be one of [none temporary sticky], got always")),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareLoadBalancer_GeoBalanced(t *testing.T) {
 	t.Parallel()
 	var loadBalancer cloudflare.LoadBalancer
@@ -490,6 +512,20 @@ resource "cloudflare_load_balancer" "%[3]s" {
 }`, zoneID, zone, id)
 }
 
+func testAccCheckCloudflareLoadBalancerConfigZeroDowntimeFailover(zoneID, zone, id, sessionAffinity, zeroDowntimeFailover string) string {
+	return testAccCheckCloudflareLoadBalancerPoolConfigBasic(id) + fmt.Sprintf(`
+resource "cloudflare_load_balancer" "%[3]s" {
+  zone_id          = "%[1]s"
+  name             = "tf-testacc-lb-zero-downtime-failover-%[3]s.%[2]s"
+  fallback_pool_id = "${cloudflare_load_balancer_pool.%[3]s.id}"
+  default_pool_ids = ["${cloudflare_load_balancer_pool.%[3]s.id}"]
+  session_affinity = "%[4]s"
+  session_affinity_attributes = {
+    zero_downtime_failover = "%[5]s"
+  }
+}`, zoneID, zone, id, sessionAffinity, zeroDowntimeFailover)
+}
+
 func testAccCheckCloudflareLoadBalancerConfigGeoBalanced(zoneID, zone, id string) string {
 	return testAccCheckCloudflareLoadBalancerPoolConfigBasic(id) + fmt.Sprintf(`
 resource "cloudflare_load_balancer" "%[3]s" {
@@ -584,3 +620,81 @@ resource "cloudflare_load_balancer" "%[3]s" {
   }
 }`, zoneID, zone, id)
 }
+
+func TestLoadBalancerValidateZeroDowntimeFailover(t *testing.T) {
+	testCases := map[string]struct {
+		config      map[string]interface{}
+		expectError string
+	}{
+		"session affinity enabled": {
+			config: map[string]interface{}{
+				"session_affinity":            "cookie",
+				"session_affinity_attributes": map[string]interface{}{"zero_downtime_failover": "sticky"},
+			},
+		},
+		"session affinity disabled": {
+			config: map[string]interface{}{
+				"session_affinity_attributes": map[string]interface{}{"zero_downtime_failover": "temporary"},
+			},
+			expectError: "session_affinity_attributes.zero_downtime_failover can only be set when session affinity is enabled",
+		},
+		"unsupported value": {
+			config: map[string]interface{}{
+				"session_affinity":            "ip_cookie",
+				"session_affinity_attributes": map[string]interface{}{"zero_downtime_failover": "always"},
+			},
+			expectError: "expected session_affinity_attributes.zero_downtime_failover to be one of [none temporary sticky], got always",
+		},
+		"rule override inheriting session affinity": {
+			config: map[string]interface{}{
+				"session_affinity": "cookie",
+				"rules": []interface{}{map[string]interface{}{
+					"name":      "example",
+					"condition": "true",
+					"overrides": []interface{}{map[string]interface{}{
+						"session_affinity_attributes": map[string]interface{}{"zero_downtime_failover": "temporary"},
+					}},
+				}},
+			},
+		},
+		"rule override disabling session affinity": {
+			config: map[string]interface{}{
+				"session_affinity": "cookie",
+				"rules": []interface{}{map[string]interface{}{
+					"name":      "example",
+					"condition": "true",
+					"overrides": []interface{}{map[string]interface{}{
+						"session_affinity":            "none",
+						"session_affinity_attributes": map[string]interface{}{"zero_downtime_failover": "temporary"},
+					}},
+				}},
+			},
+			expectError: "rules.0.overrides.0.session_affinity_attributes.zero_downtime_failover can only be set when session affinity is enabled",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"zone_id":          "0da42c8d2132a9ddaf714f9e7c920711",
+				"name":             "lb.example.com",
+				"fallback_pool_id": "17b5962d775c646f3f9725cbc7a53df4",
+				"default_pool_ids": []interface{}{"17b5962d775c646f3f9725cbc7a53df4"},
+			}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+
+			_, err := resourceCloudflareLoadBalancer().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), nil)
+			if tc.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectError {
+				t.Errorf("expected error %q, got %v", tc.expectError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// magicTransitSiteACL is a Magic WAN site ACL controlling the traffic allowed
+// between two LANs of a site.
+type magicTransitSiteACL struct {
+	ID             string                 `json:"id,omitempty"`
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description,omitempty"`
+	LAN1           magicTransitSiteACLLAN `json:"lan_1"`
+	LAN2           magicTransitSiteACLLAN `json:"lan_2"`
+	Protocols      []string               `json:"protocols,omitempty"`
+	ForwardLocally bool                   `json:"forward_locally"`
+}
+
+type magicTransitSiteACLLAN struct {
+	LANID   string   `json:"lan_id"`
+	LANName string   `json:"lan_name,omitempty"`
+	Ports   []int    `json:"ports,omitempty"`
+	Subnets []string `json:"subnets,omitempty"`
+}
+
+func resourceCloudflareMagicTransitSiteACL() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMagicTransitSiteACLSchema(),
+		CreateContext: resourceCloudflareMagicTransitSiteACLCreate,
+		ReadContext:   resourceCloudflareMagicTransitSiteACLRead,
+		UpdateContext: resourceCloudflareMagicTransitSiteACLUpdate,
+		DeleteContext: resourceCloudflareMagicTransitSiteACLDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMagicTransitSiteACLImport,
+		},
+		CustomizeDiff: resourceCloudflareMagicTransitSiteACLValidatePorts,
+		Description:   "Provides a Cloudflare Magic WAN site ACL resource. Site ACLs control which traffic is allowed between two LANs of a site.",
+	}
+}
+
+func resourceCloudflareMagicTransitSiteACLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	var acl magicTransitSiteACL
+	err := rawAPIRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/magic/sites/%s/acls/%s", accountID, siteID, d.Id()), nil, &acl)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Magic Transit site ACL %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Magic Transit site ACL %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", acl.Name)
+	d.Set("description", acl.Description)
+	d.Set("protocols", acl.Protocols)
+	d.Set("forward_locally", acl.ForwardLocally)
+
+	if err := d.Set("lan_1", flattenMagicTransitSiteACLLAN(acl.LAN1)); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Magic Transit site ACL lan_1: %w", err))
+	}
+
+	if err := d.Set("lan_2", flattenMagicTransitSiteACLLAN(acl.LAN2)); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Magic Transit site ACL lan_2: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicTransitSiteACLCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	newACL := buildMagicTransitSiteACL(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Magic Transit site ACL from struct: %+v", newACL))
+
+	var acl magicTransitSiteACL
+	err := rawAPIRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/magic/sites/%s/acls", accountID, siteID), newACL, &acl)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Magic Transit site ACL for site %q: %w", siteID, err))
+	}
+
+	if acl.ID == "" {
+		return diag.FromErr(fmt.Errorf("failed to find Magic Transit site ACL ID in create response; resource was empty"))
+	}
+
+	d.SetId(acl.ID)
+
+	return resourceCloudflareMagicTransitSiteACLRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicTransitSiteACLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	updatedACL := buildMagicTransitSiteACL(d)
+	updatedACL.ID = d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Magic Transit site ACL from struct: %+v", updatedACL))
+
+	err := rawAPIRequest(ctx, client, http.MethodPut, fmt.Sprintf("/accounts/%s/magic/sites/%s/acls/%s", accountID, siteID, d.Id()), updatedACL, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Magic Transit site ACL %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareMagicTransitSiteACLRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicTransitSiteACLDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	siteID := d.Get("site_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Magic Transit site ACL using ID: %s", d.Id()))
+
+	err := rawAPIRequest(ctx, client, http.MethodDelete, fmt.Sprintf("/accounts/%s/magic/sites/%s/acls/%s", accountID, siteID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Magic Transit site ACL %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicTransitSiteACLImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/siteID/aclID\"", d.Id())
+	}
+
+	accountID, siteID, aclID := attributes[0], attributes[1], attributes[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Magic Transit site ACL: id %s for site %s", aclID, siteID))
+
+	d.Set("account_id", accountID)
+	d.Set("site_id", siteID)
+	d.SetId(aclID)
+
+	resourceCloudflareMagicTransitSiteACLRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareMagicTransitSiteACLValidatePorts ensures ports are only
+// used when the ACL matches a protocol that has them.
+func resourceCloudflareMagicTransitSiteACLValidatePorts(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	protocols := d.Get("protocols").(*schema.Set)
+	if protocols.Len() == 0 || protocols.Contains("tcp") || protocols.Contains("udp") {
+		return nil
+	}
+
+	for _, lan := range []string{"lan_1", "lan_2"} {
+		if d.Get(fmt.Sprintf("%s.0.ports", lan)).(*schema.Set).Len() > 0 {
+			return fmt.Errorf("%s.ports can only be set when protocols is empty or includes \"tcp\" or \"udp\"", lan)
+		}
+	}
+
+	return nil
+}
+
+func buildMagicTransitSiteACL(d *schema.ResourceData) magicTransitSiteACL {
+	return magicTransitSiteACL{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		LAN1:           expandMagicTransitSiteACLLAN(d.Get("lan_1.0").(map[string]interface{})),
+		LAN2:           expandMagicTransitSiteACLLAN(d.Get("lan_2.0").(map[string]interface{})),
+		Protocols:      expandInterfaceToStringList(d.Get("protocols").(*schema.Set).List()),
+		ForwardLocally: d.Get("forward_locally").(bool),
+	}
+}
+
+func expandMagicTransitSiteACLLAN(lan map[string]interface{}) magicTransitSiteACLLAN {
+	var ports []int
+	for _, port := range lan["ports"].(*schema.Set).List() {
+		ports = append(ports, port.(int))
+	}
+
+	return magicTransitSiteACLLAN{
+		LANID:   lan["lan_id"].(string),
+		Ports:   ports,
+		Subnets: expandInterfaceToStringList(lan["subnets"].(*schema.Set).List()),
+	}
+}
+
+func flattenMagicTransitSiteACLLAN(lan magicTransitSiteACLLAN) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"lan_id":   lan.LANID,
+		"lan_name": lan.LANName,
+		"ports":    lan.Ports,
+		"subnets":  lan.Subnets,
+	}}
+}
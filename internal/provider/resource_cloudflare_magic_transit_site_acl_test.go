@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareMagicTransitSiteACL_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_magic_transit_site_acl.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	siteID := os.Getenv("CLOUDFLARE_MAGIC_WAN_SITE_ID")
+	lan1ID := os.Getenv("CLOUDFLARE_MAGIC_WAN_LAN_1_ID")
+	lan2ID := os.Getenv("CLOUDFLARE_MAGIC_WAN_LAN_2_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+			testAccPreCheckMagicWANSite(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareMagicTransitSiteACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMagicTransitSiteACLConfig(rnd, accountID, siteID, lan1ID, lan2ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "site_id", siteID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "lan_1.0.lan_id", lan1ID),
+					resource.TestCheckResourceAttr(name, "lan_1.0.ports.#", "1"),
+					resource.TestCheckResourceAttr(name, "lan_1.0.subnets.#", "1"),
+					resource.TestCheckResourceAttr(name, "lan_2.0.lan_id", lan2ID),
+					resource.TestCheckResourceAttr(name, "protocols.#", "2"),
+					resource.TestCheckResourceAttr(name, "forward_locally", "true"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/%s/", accountID, siteID),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareMagicTransitSiteACL_PortsWithoutProtocol(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "cloudflare_magic_transit_site_acl" "%[1]s" {
+  account_id = "%[2]s"
+  site_id    = "bf2d6a0b0f7e4e8c9d1c2b3a4f5e6d7c"
+  name       = "%[1]s"
+  protocols  = ["icmp"]
+
+  lan_1 {
+    lan_id = "c4a0b7e2f1d94c3a8e5b6d7f8a9b0c1d"
+    ports  = [443]
+  }
+
+  lan_2 {
+    lan_id = "0e1f2a3b4c5d4e6f8a9b0c1d2e3f4a5b"
+  }
+}`, rnd, accountID),
+				ExpectError: regexp.MustCompile(`lan_1.ports can only be set when protocols is empty or includes "tcp" or "udp"`),
+			},
+		},
+	})
+}
+
+func TestMagicTransitSiteACLCreate(t *testing.T) {
+	var created magicTransitSiteACL
+	siteURL := fmt.Sprintf("/accounts/%s/magic/sites/bf2d6a0b0f7e4e8c9d1c2b3a4f5e6d7c/acls", testAccCloudflareAccountID)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(siteURL, func(w http.ResponseWriter, r *http.Request) {
+		if !decodeTestRequestBody(t, w, r, &created) {
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "5a6b7c8d9e0f4a1b8c2d3e4f5a6b7c8d"}}`)
+	})
+	mux.HandleFunc(siteURL+"/5a6b7c8d9e0f4a1b8c2d3e4f5a6b7c8d", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+			"id": "5a6b7c8d9e0f4a1b8c2d3e4f5a6b7c8d",
+			"name": "office-to-lab",
+			"lan_1": {"lan_id": "c4a0b7e2f1d94c3a8e5b6d7f8a9b0c1d", "lan_name": "office", "ports": [443], "subnets": ["192.0.2.0/24"]},
+			"lan_2": {"lan_id": "0e1f2a3b4c5d4e6f8a9b0c1d2e3f4a5b", "lan_name": "lab"},
+			"protocols": ["tcp"],
+			"forward_locally": true
+		}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareMagicTransitSiteACLSchema(), map[string]interface{}{
+		"account_id": testAccCloudflareAccountID,
+		"site_id":    "bf2d6a0b0f7e4e8c9d1c2b3a4f5e6d7c",
+		"name":       "office-to-lab",
+		"lan_1": []interface{}{map[string]interface{}{
+			"lan_id":  "c4a0b7e2f1d94c3a8e5b6d7f8a9b0c1d",
+			"ports":   []interface{}{443},
+			"subnets": []interface{}{"192.0.2.0/24"},
+		}},
+		"lan_2": []interface{}{map[string]interface{}{
+			"lan_id": "0e1f2a3b4c5d4e6f8a9b0c1d2e3f4a5b",
+		}},
+		"protocols":       []interface{}{"tcp"},
+		"forward_locally": true,
+	})
+
+	if diags := resourceCloudflareMagicTransitSiteACLCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error creating site ACL: %v", diags)
+	}
+
+	if created.Name != "office-to-lab" || !created.ForwardLocally || len(created.Protocols) != 1 || created.Protocols[0] != "tcp" {
+		t.Errorf("unexpected create request body: %+v", created)
+	}
+
+	if created.LAN1.LANID != "c4a0b7e2f1d94c3a8e5b6d7f8a9b0c1d" || len(created.LAN1.Ports) != 1 || created.LAN1.Ports[0] != 443 {
+		t.Errorf("unexpected lan_1 in create request body: %+v", created.LAN1)
+	}
+
+	if d.Id() != "5a6b7c8d9e0f4a1b8c2d3e4f5a6b7c8d" {
+		t.Errorf("expected ID to be set from the create response, got %q", d.Id())
+	}
+
+	if got := d.Get("lan_1.0.lan_name").(string); got != "office" {
+		t.Errorf("expected lan_1.lan_name to be read back, got %q", got)
+	}
+
+	if !d.Get("lan_1.0.ports").(*schema.Set).Contains(443) {
+		t.Errorf("expected lan_1.ports to contain 443, got %v", d.Get("lan_1.0.ports"))
+	}
+}
+
+func testAccCloudflareMagicTransitSiteACLConfig(rnd, accountID, siteID, lan1ID, lan2ID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_magic_transit_site_acl" "%[1]s" {
+  account_id      = "%[2]s"
+  site_id         = "%[3]s"
+  name            = "%[1]s"
+  description     = "%[1]s description"
+  protocols       = ["tcp", "udp"]
+  forward_locally = true
+
+  lan_1 {
+    lan_id  = "%[4]s"
+    ports   = [443]
+    subnets = ["192.0.2.0/24"]
+  }
+
+  lan_2 {
+    lan_id = "%[5]s"
+  }
+}
+`, rnd, accountID, siteID, lan1ID, lan2ID)
+}
+
+func testAccCheckCloudflareMagicTransitSiteACLDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_magic_transit_site_acl" {
+			continue
+		}
+
+		err := rawAPIRequest(context.Background(), client, http.MethodGet, fmt.Sprintf("/accounts/%s/magic/sites/%s/acls/%s", rs.Primary.Attributes["account_id"], rs.Primary.Attributes["site_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("magic transit site ACL still exists")
+		}
+	}
+
+	return nil
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -26,6 +30,7 @@ func resourceCloudflareRecord() *schema.Resource {
 
 		SchemaVersion: 2,
 		Schema:        resourceCloudflareRecordSchema(),
+		CustomizeDiff: resourceCloudflareRecordValidateData,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
 			Update: schema.DefaultTimeout(30 * time.Second),
@@ -40,6 +45,51 @@ func resourceCloudflareRecord() *schema.Resource {
 	}
 }
 
+func resourceCloudflareRecordValidateData(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	data, ok := d.Get("data").([]interface{})
+	if !ok || len(data) == 0 || data[0] == nil {
+		return nil
+	}
+
+	fields := data[0].(map[string]interface{})
+	for field := range fields {
+		if !d.NewValueKnown("data.0." + field) {
+			return nil
+		}
+	}
+
+	recordType := d.Get("type").(string)
+	if missing := recordDataMissingFields(recordType, d.GetRawConfig()); len(missing) > 0 {
+		return fmt.Errorf("%s record `data` must set %s", recordType, strings.Join(missing, ", "))
+	}
+
+	return validateRecordData(recordType, fields)
+}
+
+// dnsRecordRequiredDataFields are the `data` fields that have to be
+// configured for a record type, as their zero value is a valid value and
+// can't be told apart from an unset field once read from the schema.
+var dnsRecordRequiredDataFields = map[string][]string{
+	"TLSA": {"usage", "selector", "matching_type"},
+}
+
+// recordDataMissingFields returns the required `data` fields of the record
+// type that are absent from the raw configuration.
+func recordDataMissingFields(recordType string, rawConfig cty.Value) []string {
+	if rawConfig.IsNull() {
+		return nil
+	}
+
+	var missing []string
+	for _, field := range dnsRecordRequiredDataFields[recordType] {
+		if getRawValue("data.0."+field, rawConfig).IsNull() {
+			missing = append(missing, field)
+		}
+	}
+
+	return missing
+}
+
 func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -85,10 +135,7 @@ func resourceCloudflareRecordCreate(ctx context.Context, d *schema.ResourceData,
 			valueOk, dataOk))
 	}
 
-	if priority, ok := d.GetOkExists("priority"); ok {
-		p := uint16(priority.(int))
-		newRecord.Priority = &p
-	}
+	newRecord.Priority = dnsRecordPriority(d)
 
 	if ttl, ok := d.GetOk("ttl"); ok {
 		if ttl.(int) != 1 && proxiedOk && *newRecord.Proxied {
@@ -216,6 +263,10 @@ func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, m
 				readDataMap[id] = newData
 			}
 
+			if p, _ := dataMap.(map[string]interface{})["priority"].(int); p != 0 && record.Type == "URI" && record.Priority != nil {
+				readDataMap["priority"] = int(*record.Priority)
+			}
+
 			record.Data = []interface{}{readDataMap}
 		}
 	}
@@ -234,10 +285,8 @@ func resourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	d.Set("proxiable", record.Proxiable)
 
-	if record.Priority != nil {
-		priority := record.Priority
-		p := *priority
-		d.Set("priority", int(p))
+	if record.Priority != nil && contains(dnsRecordPriorityTypes, record.Type) {
+		d.Set("priority", int(*record.Priority))
 	}
 
 	return nil
@@ -275,10 +324,7 @@ func resourceCloudflareRecordUpdate(ctx context.Context, d *schema.ResourceData,
 		updateRecord.Data = newDataMap
 	}
 
-	if priority, ok := d.GetOkExists("priority"); ok {
-		p := uint16(priority.(int))
-		updateRecord.Priority = &p
-	}
+	updateRecord.Priority = dnsRecordPriority(d)
 
 	proxied, proxiedOk := d.GetOkExists("proxied")
 	if proxiedOk {
@@ -348,14 +394,23 @@ func resourceCloudflareRecordImport(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*cloudflare.API)
 
 	// split the id so we can lookup
-	idAttr := strings.SplitN(d.Id(), "/", 2)
+	idAttr := strings.SplitN(d.Id(), "/", 3)
 	var zoneID string
 	var recordID string
-	if len(idAttr) == 2 {
+	switch len(idAttr) {
+	case 2:
 		zoneID = idAttr[0]
 		recordID = idAttr[1]
-	} else {
-		return nil, fmt.Errorf("invalid id %q specified, should be in format \"zoneID/recordID\" for import", d.Id())
+	case 3:
+		zoneID = idAttr[0]
+
+		var err error
+		recordID, err = dnsRecordIDByNameAndType(ctx, client, zoneID, idAttr[1], idAttr[2])
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("invalid id %q specified, should be in format \"zoneID/recordID\" or \"zoneID/name/type\" for import", d.Id())
 	}
 
 	record, err := client.DNSRecord(ctx, zoneID, recordID)
@@ -375,6 +430,31 @@ func resourceCloudflareRecordImport(ctx context.Context, d *schema.ResourceData,
 	return []*schema.ResourceData{d}, nil
 }
 
+// dnsRecordIDByNameAndType resolves the ID of the single DNS record in a zone
+// with the given fully qualified name and type.
+func dnsRecordIDByNameAndType(ctx context.Context, client *cloudflare.API, zoneID, name, recordType string) (string, error) {
+	records, err := client.DNSRecords(ctx, zoneID, cloudflare.DNSRecord{
+		Name: strings.TrimSuffix(name, "."),
+		Type: strings.ToUpper(recordType),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error listing DNS records for zone %q: %w", zoneID, err)
+	}
+
+	switch len(records) {
+	case 0:
+		return "", fmt.Errorf("no %s record found in zone %q with name %q", strings.ToUpper(recordType), zoneID, name)
+	case 1:
+		return records[0].ID, nil
+	default:
+		var ids []string
+		for _, record := range records {
+			ids = append(ids, record.ID)
+		}
+		return "", fmt.Errorf("multiple %s records found in zone %q with name %q, import one of them by ID instead: %s", strings.ToUpper(recordType), zoneID, name, strings.Join(ids, ", "))
+	}
+}
+
 var dnsTypeIntFields = []string{
 	"algorithm",
 	"key_tag",
@@ -406,6 +486,10 @@ var dnsTypeFloatFields = []string{
 
 func transformToCloudflareDNSData(recordType string, id string, value interface{}) (newValue interface{}, err error) {
 	switch {
+	case id == "priority" && strings.ToUpper(recordType) == "URI":
+		// URI records carry their priority outside of `data`, see
+		// dnsRecordPriority.
+		newValue, err = nil, nil
 	case id == "flags":
 		switch {
 		case strings.ToUpper(recordType) == "SRV",
@@ -426,10 +510,135 @@ func transformToCloudflareDNSData(recordType string, id string, value interface{
 	return
 }
 
+// dnsRecordPriorityTypes are the record types whose priority is set with the
+// top level `priority` rather than within `data`.
+var dnsRecordPriorityTypes = []string{"MX", "URI"}
+
+// dnsRecordPriority returns the top level priority to send for the record.
+// URI records may also configure a non-zero priority within `data` alongside
+// their other fields, the API only accepts it at the top level though.
+func dnsRecordPriority(d *schema.ResourceData) *uint16 {
+	recordType := d.Get("type").(string)
+	if !contains(dnsRecordPriorityTypes, recordType) {
+		return nil
+	}
+
+	if priority, ok := d.GetOk("data.0.priority"); ok && recordType == "URI" {
+		p := uint16(priority.(int))
+		return &p
+	}
+
+	//nolint:staticcheck
+	if priority, ok := d.GetOkExists("priority"); ok {
+		p := uint16(priority.(int))
+		return &p
+	}
+
+	return nil
+}
+
 func suppressPriority(k, old, new string, d *schema.ResourceData) bool {
 	recordType := d.Get("type").(string)
-	if recordType != "MX" && recordType != "URI" {
+	if !contains(dnsRecordPriorityTypes, recordType) {
+		return true
+	}
+
+	// The priority of URI records configured within `data` is mirrored to
+	// the top level by the API.
+	if _, ok := d.GetOk("data.0.priority"); ok && recordType == "URI" {
 		return true
 	}
+
 	return false
 }
+
+// svcbParamKeys maps the SvcParamKey names of HTTPS and SVCB records to their
+// registered numbers, which is the order the API returns them in.
+var svcbParamKeys = map[string]int{
+	"mandatory":       0,
+	"alpn":            1,
+	"no-default-alpn": 2,
+	"port":            3,
+	"ipv4hint":        4,
+	"ech":             5,
+	"ipv6hint":        6,
+}
+
+// svcbParamKeyNumber returns the number of a SvcParamKey, including the
+// generic `keyNNNNN` form, or -1 when the key is unknown.
+func svcbParamKeyNumber(key string) int {
+	if n, ok := svcbParamKeys[key]; ok {
+		return n
+	}
+
+	if n, err := strconv.Atoi(strings.TrimPrefix(key, "key")); err == nil && strings.HasPrefix(key, "key") {
+		return n
+	}
+
+	return -1
+}
+
+// normalizeSVCBParams returns the canonical form of the SvcParams of an HTTPS
+// or SVCB record so that the quoting and ordering applied by the API don't
+// produce a diff. Parameters are sorted by key and their values unquoted.
+func normalizeSVCBParams(value string) string {
+	var params []string
+	var current strings.Builder
+	inQuotes, escaped := false, false
+
+	flush := func() {
+		if current.Len() > 0 {
+			params = append(params, current.String())
+			current.Reset()
+		}
+	}
+
+	for _, r := range value {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			current.WriteRune(r)
+			escaped = true
+		case r == '"':
+			inQuotes = !inQuotes
+		case unicode.IsSpace(r) && !inQuotes:
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	for i, param := range params {
+		parts := strings.SplitN(param, "=", 2)
+		parts[0] = strings.ToLower(parts[0])
+		params[i] = strings.Join(parts, "=")
+	}
+
+	sort.SliceStable(params, func(i, j int) bool {
+		keyI := strings.SplitN(params[i], "=", 2)[0]
+		keyJ := strings.SplitN(params[j], "=", 2)[0]
+
+		numI, numJ := svcbParamKeyNumber(keyI), svcbParamKeyNumber(keyJ)
+		if numI != numJ {
+			return numI < numJ
+		}
+
+		return keyI < keyJ
+	})
+
+	return strings.Join(params, " ")
+}
+
+// suppressEquivalentSVCBParams suppresses the diff of `data.value` when the
+// SvcParams of an HTTPS or SVCB record are only canonicalised differently.
+func suppressEquivalentSVCBParams(k, old, new string, d *schema.ResourceData) bool {
+	switch d.Get("type").(string) {
+	case "HTTPS", "SVCB":
+		return normalizeSVCBParams(old) == normalizeSVCBParams(new)
+	}
+
+	return old == new
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
@@ -122,6 +126,51 @@ func TestAccCloudflareRecord_CaseInsensitive(t *testing.T) {
 	})
 }
 
+func TestDNSRecordIDByNameAndType(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/dns_records", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+
+		var result string
+		switch r.URL.Query().Get("name") + "/" + r.URL.Query().Get("type") {
+		case "www.example.com/A":
+			result = `[{"id": "372e67954025e0ba6aaa6d586b9e0b59", "type": "A", "name": "www.example.com", "content": "198.51.100.4"}]`
+		case "mail.example.com/MX":
+			result = `[
+				{"id": "9a7806061c88ada191ed06f989cc3dac", "type": "MX", "name": "mail.example.com", "content": "mx1.example.com"},
+				{"id": "f8d3b8ae7a1e4b4c9b1d2e5f6a7b8c9d", "type": "MX", "name": "mail.example.com", "content": "mx2.example.com"}
+			]`
+		default:
+			result = `[]`
+		}
+
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s, "result_info": {"page": 1, "per_page": 100, "total_pages": 1, "count": 1, "total_count": 1}}`, result)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	ctx := context.Background()
+
+	id, err := dnsRecordIDByNameAndType(ctx, client, testAccCloudflareZoneID, "www.example.com", "a")
+	if err != nil || id != "372e67954025e0ba6aaa6d586b9e0b59" {
+		t.Errorf("expected record 372e67954025e0ba6aaa6d586b9e0b59, got %q (err: %v)", id, err)
+	}
+
+	if _, err = dnsRecordIDByNameAndType(ctx, client, testAccCloudflareZoneID, "www.example.com", "AAAA"); err == nil {
+		t.Error("expected an error when no record matches the name and type")
+	}
+
+	_, err = dnsRecordIDByNameAndType(ctx, client, testAccCloudflareZoneID, "mail.example.com", "MX")
+	if err == nil {
+		t.Fatal("expected an error when multiple records match the name and type")
+	}
+	for _, candidate := range []string{"9a7806061c88ada191ed06f989cc3dac", "f8d3b8ae7a1e4b4c9b1d2e5f6a7b8c9d"} {
+		if !strings.Contains(err.Error(), candidate) {
+			t.Errorf("expected error to list candidate record %s, got: %s", candidate, err)
+		}
+	}
+}
+
 func TestAccCloudflareRecord_Apex(t *testing.T) {
 	t.Parallel()
 	var record cloudflare.DNSRecord
@@ -253,6 +302,105 @@ func TestAccCloudflareRecord_CAA(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_CAAWithInvalidTag(t *testing.T) {
+	t.Parallel()
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareRecordConfigCAAWithTag(rnd, zoneID, fmt.Sprintf("tf-acctest-caa.%s", domain), "issues"),
+				ExpectError: regexp.MustCompile("CAA record `data.tag` must be one of issue, issuewild, iodef"),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_TLSA(t *testing.T) {
+	t.Parallel()
+	var record cloudflare.DNSRecord
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
+	certificate := "0C72AC70B745AC19998811B131D662C9AC69DBDBE7CB23E5B514B56664C5D3D6"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigTLSA(rnd, zoneID, fmt.Sprintf("_443._tcp.tf-acctest-tlsa.%s", domain), certificate),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "type", "TLSA"),
+					resource.TestCheckResourceAttr(resourceName, "data.0.usage", "3"),
+					resource.TestCheckResourceAttr(resourceName, "data.0.selector", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.0.matching_type", "1"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareRecordConfigTLSA(rnd, zoneID, fmt.Sprintf("_443._tcp.tf-acctest-tlsa.%s", domain), certificate),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_HTTPS(t *testing.T) {
+	t.Parallel()
+	var record cloudflare.DNSRecord
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigHTTPS(rnd, zoneID, fmt.Sprintf("tf-acctest-https.%s", domain)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "type", "HTTPS"),
+					resource.TestCheckResourceAttr(resourceName, "data.0.priority", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.0.target", "."),
+					resource.TestCheckResourceAttr(resourceName, "data.0.value", `alpn="h3,h2" ipv4hint="192.0.2.1"`),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareRecordConfigHTTPS(rnd, zoneID, fmt.Sprintf("tf-acctest-https.%s", domain)),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_DataFieldsMustMatchType(t *testing.T) {
+	t.Parallel()
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareRecordConfigSVCBWithSRVFields(rnd, zoneID, fmt.Sprintf("tf-acctest-svcb.%s", domain)),
+				ExpectError: regexp.MustCompile("SVCB records do not support the `data` fields port, proto"),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRecord_Proxied(t *testing.T) {
 	t.Parallel()
 	var record cloudflare.DNSRecord
@@ -483,6 +631,160 @@ func TestAccCloudflareRecord_MXWithPriorityZero(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_MXPriority(t *testing.T) {
+	t.Parallel()
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_record." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigMXWithPriority(zoneID, rnd, zoneName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "value", "mail.terraform.cfapi.net"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareRecordConfigMXWithPriority(zoneID, rnd, zoneName, 65535),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "65535"),
+				),
+			},
+			{
+				Config:      testAccCheckCloudflareRecordConfigMXWithPriority(zoneID, rnd, zoneName, 65536),
+				ExpectError: regexp.MustCompile(`expected priority to be in the range \(0 - 65535\)`),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_SRVPriority(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_record." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigSRVWithPriority(zoneID, "tf-acctest-srv-priority", rnd, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.0.priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "value", "10	5222	talk.l.google.com"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareRecordConfigSRVWithPriority(zoneID, "tf-acctest-srv-priority", rnd, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.0.priority", "20"),
+					resource.TestCheckResourceAttr(resourceName, "value", "10	5222	talk.l.google.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestDNSRecordPriority(t *testing.T) {
+	testCases := map[string]struct {
+		config map[string]interface{}
+		want   *uint16
+	}{
+		"MX": {
+			config: map[string]interface{}{"type": "MX", "value": "mx.example.com", "priority": 10},
+			want:   cloudflare.Uint16Ptr(10),
+		},
+		"MX with priority zero": {
+			config: map[string]interface{}{"type": "MX", "value": "mx.example.com", "priority": 0},
+			want:   cloudflare.Uint16Ptr(0),
+		},
+		"URI": {
+			config: map[string]interface{}{"type": "URI", "priority": 5, "data": []interface{}{map[string]interface{}{"weight": 1, "content": "https://example.com"}}},
+			want:   cloudflare.Uint16Ptr(5),
+		},
+		"URI with priority in data": {
+			config: map[string]interface{}{"type": "URI", "data": []interface{}{map[string]interface{}{"priority": 5, "weight": 1, "content": "https://example.com"}}},
+			want:   cloudflare.Uint16Ptr(5),
+		},
+		"SRV": {
+			config: map[string]interface{}{"type": "SRV", "priority": 5, "data": []interface{}{map[string]interface{}{"priority": 10, "port": 5222, "target": "talk.l.google.com"}}},
+		},
+		"A": {
+			config: map[string]interface{}{"type": "A", "value": "192.0.2.1", "priority": 5},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceCloudflareRecordSchema(), tc.config)
+
+			got := dnsRecordPriority(d)
+			switch {
+			case tc.want == nil && got != nil:
+				t.Errorf("expected no top level priority, got %d", *got)
+			case tc.want != nil && (got == nil || *got != *tc.want):
+				t.Errorf("expected top level priority %d, got %v", *tc.want, got)
+			}
+		})
+	}
+}
+
+func TestRecordPriorityValidation(t *testing.T) {
+	testCases := map[string]struct {
+		config  map[string]interface{}
+		wantErr bool
+	}{
+		"MX in range": {
+			config: map[string]interface{}{"priority": 65535},
+		},
+		"MX out of range": {
+			config:  map[string]interface{}{"priority": 65536},
+			wantErr: true,
+		},
+		"MX negative": {
+			config:  map[string]interface{}{"priority": -1},
+			wantErr: true,
+		},
+		"SRV out of range": {
+			config: map[string]interface{}{
+				"type": "SRV",
+				"data": []interface{}{map[string]interface{}{"priority": 65536, "port": 5222, "target": "talk.l.google.com"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"zone_id": testAccCloudflareZoneID,
+				"name":    "example",
+				"type":    "MX",
+				"value":   "mx.example.com",
+			}
+			for k, v := range tc.config {
+				config[k] = v
+			}
+			if _, ok := config["data"]; ok {
+				delete(config, "value")
+			}
+
+			diags := resourceCloudflareRecord().Validate(terraform.NewResourceConfigRaw(config))
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("expected error: %t, got %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
+
 func TestAccCloudflareRecord_TtlValidationUpdate(t *testing.T) {
 	t.Parallel()
 	domain := os.Getenv("CLOUDFLARE_DOMAIN")
@@ -699,6 +1001,64 @@ resource "cloudflare_record" "%[1]s" {
 }`, resourceName, zoneID, name, ttl)
 }
 
+func testAccCheckCloudflareRecordConfigCAAWithTag(resourceName, zoneID, name, tag string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[1]s" {
+  zone_id = "%[2]s"
+  name = "%[3]s"
+  data {
+    flags = "0"
+    tag   = "%[4]s"
+    value = "letsencrypt.org"
+  }
+  type = "CAA"
+}`, resourceName, zoneID, name, tag)
+}
+
+func testAccCheckCloudflareRecordConfigTLSA(resourceName, zoneID, name, certificate string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[1]s" {
+  zone_id = "%[2]s"
+  name    = "%[3]s"
+  type    = "TLSA"
+  data {
+    usage         = 3
+    selector      = 1
+    matching_type = 1
+    certificate   = "%[4]s"
+  }
+}`, resourceName, zoneID, name, certificate)
+}
+
+func testAccCheckCloudflareRecordConfigHTTPS(resourceName, zoneID, name string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[1]s" {
+  zone_id = "%[2]s"
+  name    = "%[3]s"
+  type    = "HTTPS"
+  data {
+    priority = 1
+    target   = "."
+    value    = "alpn=\"h3,h2\" ipv4hint=\"192.0.2.1\""
+  }
+}`, resourceName, zoneID, name)
+}
+
+func testAccCheckCloudflareRecordConfigSVCBWithSRVFields(resourceName, zoneID, name string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[1]s" {
+  zone_id = "%[2]s"
+  name    = "%[3]s"
+  type    = "SVCB"
+  data {
+    priority = 1
+    target   = "svc.example.com"
+    port     = 443
+    proto    = "_tcp"
+  }
+}`, resourceName, zoneID, name)
+}
+
 func testAccCheckCloudflareRecordConfigProxied(zoneID, domain, name, rnd string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "%[4]s" {
@@ -779,3 +1139,128 @@ resource "cloudflare_record" "%[2]s" {
 	ttl = 300
 }`, zoneID, name, zoneName)
 }
+
+func testAccCheckCloudflareRecordConfigMXWithPriority(zoneID, name, zoneName string, priority int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+	zone_id = "%[1]s"
+	name = "%[2]s"
+	value = "mail.terraform.cfapi.net"
+	type = "MX"
+	priority = %[4]d
+	proxied = false
+	ttl = 300
+}`, zoneID, name, zoneName, priority)
+}
+
+func testAccCheckCloudflareRecordConfigSRVWithPriority(zoneID, name, rnd string, priority int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[3]s" {
+  zone_id = "%[1]s"
+  name = "%[2]s"
+  data {
+    priority = %[4]d
+    weight = 10
+    port = 5222
+    target = "talk.l.google.com"
+    service = "_xmpp-client"
+    proto = "_tcp"
+    name = "%[2]s"
+  }
+  type = "SRV"
+  ttl = 3600
+}`, zoneID, name, rnd, priority)
+}
+
+func TestNormalizeSVCBParams(t *testing.T) {
+	for name, tc := range map[string]struct {
+		configured string
+		returned   string
+		equivalent bool
+	}{
+		"quoted alpn": {
+			configured: "alpn=h3,h2",
+			returned:   `alpn="h3,h2"`,
+			equivalent: true,
+		},
+		"reordered parameters": {
+			configured: `ipv4hint=192.0.2.1 alpn="h2" port=8443`,
+			returned:   `alpn="h2" port="8443" ipv4hint="192.0.2.1"`,
+			equivalent: true,
+		},
+		"generic key form": {
+			configured: `key65000="x" ech=AEn+DQBF`,
+			returned:   `ech="AEn+DQBF" key65000="x"`,
+			equivalent: true,
+		},
+		"extra whitespace": {
+			configured: "alpn=h2  no-default-alpn",
+			returned:   `alpn="h2" no-default-alpn`,
+			equivalent: true,
+		},
+		"quoted whitespace is kept": {
+			configured: `key65000="a b"`,
+			returned:   `key65000="ab"`,
+			equivalent: false,
+		},
+		"different values": {
+			configured: `alpn="h3,h2"`,
+			returned:   `alpn="h2"`,
+			equivalent: false,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := normalizeSVCBParams(tc.configured) == normalizeSVCBParams(tc.returned); got != tc.equivalent {
+				t.Errorf("expected %q and %q to be equivalent: %t, got %t (%q vs %q)", tc.configured, tc.returned, tc.equivalent, got, normalizeSVCBParams(tc.configured), normalizeSVCBParams(tc.returned))
+			}
+		})
+	}
+}
+
+func TestRecordDataMissingFields(t *testing.T) {
+	tlsaData := func(usage, selector, matchingType cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"type": cty.StringVal("TLSA"),
+			"data": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"usage":         usage,
+				"selector":      selector,
+				"matching_type": matchingType,
+				"certificate":   cty.StringVal("3082"),
+			})}),
+		})
+	}
+
+	for name, tc := range map[string]struct {
+		recordType string
+		rawConfig  cty.Value
+		missing    []string
+	}{
+		"TLSA with zero values": {
+			recordType: "TLSA",
+			rawConfig:  tlsaData(cty.NumberIntVal(0), cty.NumberIntVal(0), cty.NumberIntVal(0)),
+		},
+		"TLSA without usage and matching type": {
+			recordType: "TLSA",
+			rawConfig:  tlsaData(cty.NullVal(cty.Number), cty.NumberIntVal(1), cty.NullVal(cty.Number)),
+			missing:    []string{"usage", "matching_type"},
+		},
+		"TLSA with an unknown selector": {
+			recordType: "TLSA",
+			rawConfig:  tlsaData(cty.NumberIntVal(3), cty.UnknownVal(cty.Number), cty.NumberIntVal(1)),
+		},
+		"CAA has no required fields": {
+			recordType: "CAA",
+			rawConfig:  tlsaData(cty.NullVal(cty.Number), cty.NullVal(cty.Number), cty.NullVal(cty.Number)),
+		},
+		"no raw configuration": {
+			recordType: "TLSA",
+			rawConfig:  cty.NullVal(cty.DynamicPseudoType),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := recordDataMissingFields(tc.recordType, tc.rawConfig); fmt.Sprint(got) != fmt.Sprint(tc.missing) {
+				t.Errorf("expected missing fields %v, got %v", tc.missing, got)
+			}
+		})
+	}
+}
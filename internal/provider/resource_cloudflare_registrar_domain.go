@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareRegistrarDomain() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareRegistrarDomainSchema(),
+		CreateContext: resourceCloudflareRegistrarDomainCreate,
+		ReadContext:   resourceCloudflareRegistrarDomainRead,
+		UpdateContext: resourceCloudflareRegistrarDomainUpdate,
+		DeleteContext: resourceCloudflareRegistrarDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareRegistrarDomainImport,
+		},
+		Description: "Provides a resource which manages the settings of a domain registered with Cloudflare Registrar.",
+	}
+}
+
+// registrarDomain extends cloudflare.RegistrarDomain with the settings
+// cloudflare-go doesn't return yet.
+type registrarDomain struct {
+	cloudflare.RegistrarDomain
+	AutoRenew   bool     `json:"auto_renew"`
+	Privacy     bool     `json:"privacy"`
+	NameServers []string `json:"name_servers"`
+}
+
+func registrarDomainURI(accountID, domain string) string {
+	return fmt.Sprintf("/accounts/%s/registrar/domains/%s", accountID, domain)
+}
+
+func resourceCloudflareRegistrarDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+	domain := d.Get("domain").(string)
+
+	// domains can't be registered through the API, only the settings of an
+	// already registered domain are taken over.
+	var current registrarDomain
+	if err := rawAPIRequest(ctx, client, http.MethodGet, registrarDomainURI(accountID, domain), nil, &current); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Registrar domain %q in account %q, the domain must already be registered with Cloudflare: %w", domain, accountID, err))
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Managing settings of Registrar domain %q in account %q", domain, accountID))
+
+	// the API replaces all settings at once, the ones which aren't configured
+	// keep their current values.
+	if _, ok := d.GetOkExists("auto_renew"); !ok {
+		d.Set("auto_renew", current.AutoRenew)
+	}
+	if _, ok := d.GetOkExists("locked"); !ok {
+		d.Set("locked", current.Locked)
+	}
+	if _, ok := d.GetOkExists("privacy"); !ok {
+		d.Set("privacy", current.Privacy)
+	}
+	if len(d.Get("name_servers").([]interface{})) == 0 {
+		d.Set("name_servers", current.NameServers)
+	}
+
+	d.SetId(domain)
+
+	return resourceCloudflareRegistrarDomainUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareRegistrarDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	var domain registrarDomain
+	if err := rawAPIRequest(ctx, client, http.MethodGet, registrarDomainURI(accountID, d.Id()), nil, &domain); err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Registrar domain %q not found in account %q, removing from state", d.Id(), accountID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Registrar domain %q: %w", d.Id(), err))
+	}
+
+	d.Set("domain", d.Id())
+	d.Set("auto_renew", domain.AutoRenew)
+	d.Set("locked", domain.Locked)
+	d.Set("privacy", domain.Privacy)
+	if err := d.Set("name_servers", domain.NameServers); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting name_servers: %w", err))
+	}
+	if !domain.ExpiresAt.IsZero() {
+		d.Set("expires_at", domain.ExpiresAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceCloudflareRegistrarDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	configuration := cloudflare.RegistrarDomainConfiguration{
+		AutoRenew:   d.Get("auto_renew").(bool),
+		Locked:      d.Get("locked").(bool),
+		Privacy:     d.Get("privacy").(bool),
+		NameServers: expandInterfaceToStringList(d.Get("name_servers")),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Registrar domain %q from struct: %+v", d.Id(), configuration))
+
+	if _, err := client.UpdateRegistrarDomain(ctx, accountID, d.Id(), configuration); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Registrar domain %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareRegistrarDomainRead(ctx, d, meta)
+}
+
+func resourceCloudflareRegistrarDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the registration isn't affected, the domain stays registered with its
+	// current settings.
+	tflog.Info(ctx, fmt.Sprintf("Removing Registrar domain %q from state, its registration and settings are left as is", d.Id()))
+
+	return nil
+}
+
+func resourceCloudflareRegistrarDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/domain\"", d.Id())
+	}
+
+	accountID, domain := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Registrar domain %q for account %s", domain, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(domain)
+
+	resourceCloudflareRegistrarDomainRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareRegistrarDomain_AutoRenew(t *testing.T) {
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := os.Getenv("CLOUDFLARE_REGISTRAR_DOMAIN")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_registrar_domain." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckRegistrarDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRegistrarDomainConfig(rnd, accountID, domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "domain", domain),
+					resource.TestCheckResourceAttr(name, "auto_renew", "true"),
+					resource.TestCheckResourceAttrSet(name, "expires_at"),
+				),
+			},
+			{
+				Config: testAccCloudflareRegistrarDomainConfig(rnd, accountID, domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "auto_renew", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateId:     accountID + "/" + domain,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareRegistrarDomainConfig(name, accountID, domain string, autoRenew bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_registrar_domain" "%[1]s" {
+  account_id = "%[2]s"
+  domain     = "%[3]s"
+  auto_renew = %[4]t
+}`, name, accountID, domain, autoRenew)
+}
+
+func TestRegistrarDomainCreateKeepsUnconfiguredSettings(t *testing.T) {
+	mux := http.NewServeMux()
+	domain := map[string]interface{}{
+		"id":           "ea95132c15732412d22c1476fa83f27a",
+		"locked":       true,
+		"privacy":      true,
+		"auto_renew":   false,
+		"name_servers": []string{"ns1.example.net", "ns2.example.net"},
+		"expires_at":   "2027-10-16T00:00:00Z",
+	}
+
+	var updated cloudflare.RegistrarDomainConfiguration
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/registrar/domains/example.com", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			if !decodeTestRequestBody(t, w, r, &updated) {
+				return
+			}
+			domain["auto_renew"] = updated.AutoRenew
+			domain["locked"] = updated.Locked
+			domain["privacy"] = updated.Privacy
+			domain["name_servers"] = updated.NameServers
+		}
+
+		result, _ := json.Marshal(domain)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, result)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareRegistrarDomainSchema(), map[string]interface{}{
+		"account_id": testAccCloudflareAccountID,
+		"domain":     "example.com",
+		"auto_renew": true,
+	})
+
+	if diags := resourceCloudflareRegistrarDomainCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := cloudflare.RegistrarDomainConfiguration{
+		AutoRenew:   true,
+		Locked:      true,
+		Privacy:     true,
+		NameServers: []string{"ns1.example.net", "ns2.example.net"},
+	}
+	if !reflect.DeepEqual(updated, expected) {
+		t.Errorf("expected update %+v, got %+v", expected, updated)
+	}
+
+	if d.Id() != "example.com" {
+		t.Errorf("expected ID to be the domain, got %q", d.Id())
+	}
+	if got := d.Get("expires_at").(string); got != "2027-10-16T00:00:00Z" {
+		t.Errorf("expected expires_at to be read, got %q", got)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"reflect"
 	"regexp"
 	"sort"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -21,6 +23,155 @@ const (
 	duplicateRulesetError        = "failed to create ruleset %q as a similar configuration with rules already exists and overwriting will have unintended consequences. If you are migrating from the Dashboard, you will need to first remove the existing rules otherwise you can remove the existing phase yourself using the API (%s)."
 )
 
+const (
+	rulesetPhaseHTTPCustomErrors        = "http_custom_errors"
+	rulesetPhaseHTTPResponseCompression = "http_response_compression"
+	rulesetRuleActionCompressResponse   = "compress_response"
+	rulesetRuleActionServeError         = "serve_error"
+)
+
+// rulesetCompressionAlgorithms are the algorithms available to the
+// `compress_response` action.
+var rulesetCompressionAlgorithms = []string{"auto", "brotli", "default", "gzip", "none", "zstd"}
+
+// rulesetServeErrorContentTypes are the content types the `serve_error`
+// action can respond with.
+var rulesetServeErrorContentTypes = []string{"application/json", "text/html", "text/plain", "text/xml"}
+
+// rulesetBody, rulesetRule and rulesetRuleActionParameters extend the
+// cloudflare-go ruleset types with the fields cloudflare-go doesn't support
+// yet. Rulesets only go through these types, and a raw API request, when their
+// rules use one of the additional fields; everything else uses cloudflare-go.
+type rulesetBody struct {
+	cloudflare.Ruleset
+	Rules []rulesetRule `json:"rules"`
+}
+
+type rulesetRule struct {
+	cloudflare.RulesetRule
+	ActionParameters *rulesetRuleActionParameters `json:"action_parameters,omitempty"`
+	RateLimit        *rulesetRuleRateLimit        `json:"ratelimit,omitempty"`
+}
+
+type rulesetRuleRateLimit struct {
+	cloudflare.RulesetRuleRateLimit
+	ScorePerPeriod          int    `json:"score_per_period,omitempty"`
+	ScoreResponseHeaderName string `json:"score_response_header_name,omitempty"`
+}
+
+type rulesetRuleActionParameters struct {
+	cloudflare.RulesetRuleActionParameters
+	Algorithms  []rulesetRuleActionParametersCompressionAlgorithm `json:"algorithms,omitempty"`
+	Content     string                                            `json:"content,omitempty"`
+	ContentType string                                            `json:"content_type,omitempty"`
+	StatusCode  uint16                                            `json:"status_code,omitempty"`
+}
+
+type rulesetRuleActionParametersCompressionAlgorithm struct {
+	Name string `json:"name"`
+}
+
+type rulesetUpdateRequest struct {
+	Description string        `json:"description"`
+	Rules       []rulesetRule `json:"rules"`
+}
+
+// rulesetPhaseValues returns the ruleset phases known to cloudflare-go along
+// with the ones the provider supports ahead of it.
+func rulesetPhaseValues() []string {
+	phases := append(cloudflare.RulesetPhaseValues(), rulesetPhaseHTTPCustomErrors, rulesetPhaseHTTPResponseCompression)
+	sort.Strings(phases)
+	return phases
+}
+
+// rulesetRuleActionValues returns the ruleset rule actions known to
+// cloudflare-go along with the ones the provider supports ahead of it.
+func rulesetRuleActionValues() []string {
+	actions := append(cloudflare.RulesetRuleActionValues(), rulesetRuleActionCompressResponse, rulesetRuleActionServeError)
+	sort.Strings(actions)
+	return actions
+}
+
+// rulesetRouteRoot returns the account or zone prefix of the ruleset API
+// endpoints.
+func rulesetRouteRoot(accountID, zoneID string) string {
+	if accountID != "" {
+		return fmt.Sprintf("%s/%s", cloudflare.AccountRouteRoot, accountID)
+	}
+	return fmt.Sprintf("%s/%s", cloudflare.ZoneRouteRoot, zoneID)
+}
+
+// rulesetRulesFromAPI wraps the rules returned by cloudflare-go so they can
+// be handled alongside the ones read with the additional fields.
+func rulesetRulesFromAPI(rules []cloudflare.RulesetRule) []rulesetRule {
+	wrapped := make([]rulesetRule, 0, len(rules))
+	for _, rule := range rules {
+		r := rulesetRule{RulesetRule: rule}
+		if rule.ActionParameters != nil {
+			r.ActionParameters = &rulesetRuleActionParameters{RulesetRuleActionParameters: *rule.ActionParameters}
+		}
+		if rule.RateLimit != nil {
+			r.RateLimit = &rulesetRuleRateLimit{RulesetRuleRateLimit: *rule.RateLimit}
+		}
+		r.RulesetRule.ActionParameters = nil
+		r.RulesetRule.RateLimit = nil
+		wrapped = append(wrapped, r)
+	}
+	return wrapped
+}
+
+// rulesetRulesToAPI unwraps the rules into the cloudflare-go types, dropping
+// any of the additional fields.
+func rulesetRulesToAPI(rules []rulesetRule) []cloudflare.RulesetRule {
+	if rules == nil {
+		return nil
+	}
+
+	unwrapped := make([]cloudflare.RulesetRule, 0, len(rules))
+	for _, rule := range rules {
+		r := rule.RulesetRule
+		if rule.ActionParameters != nil {
+			actionParameters := rule.ActionParameters.RulesetRuleActionParameters
+			r.ActionParameters = &actionParameters
+		}
+		if rule.RateLimit != nil {
+			rateLimit := rule.RateLimit.RulesetRuleRateLimit
+			r.RateLimit = &rateLimit
+		}
+		unwrapped = append(unwrapped, r)
+	}
+	return unwrapped
+}
+
+// rulesetRulesUseExtendedFields reports whether any of the rules set a field
+// cloudflare-go doesn't support yet.
+func rulesetRulesUseExtendedFields(rules []rulesetRule) bool {
+	for _, rule := range rules {
+		if ap := rule.ActionParameters; ap != nil && (len(ap.Algorithms) > 0 || ap.Content != "" || ap.ContentType != "" || ap.StatusCode != 0) {
+			return true
+		}
+		if rl := rule.RateLimit; rl != nil && (rl.ScorePerPeriod != 0 || rl.ScoreResponseHeaderName != "") {
+			return true
+		}
+	}
+	return false
+}
+
+// rulesetRulesMayUseExtendedFields reports whether any of the rules read
+// through cloudflare-go could have fields it doesn't support yet: the
+// `compress_response` and `serve_error` actions and score based rate limits.
+func rulesetRulesMayUseExtendedFields(rules []cloudflare.RulesetRule) bool {
+	for _, rule := range rules {
+		if rule.Action == rulesetRuleActionCompressResponse || rule.Action == rulesetRuleActionServeError {
+			return true
+		}
+		if rule.RateLimit != nil && rule.RateLimit.RequestsPerPeriod == 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceCloudflareRuleset() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareRulesetSchema(),
@@ -31,6 +182,13 @@ func resourceCloudflareRuleset() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareRulesetImport,
 		},
+		CustomizeDiff: customdiff.All(
+			resourceCloudflareRulesetValidateListReferences,
+			resourceCloudflareRulesetValidateCacheKeyQueryString,
+			resourceCloudflareRulesetValidateRateLimit,
+			resourceCloudflareRulesetValidateLogAction,
+			resourceCloudflareRulesetValidateServeErrorAction,
+		),
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -50,6 +208,197 @@ basic syntax.
 	}
 }
 
+func resourceCloudflareRulesetValidateListReferences(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate_list_references").(bool) || !d.NewValueKnown("rules") {
+		return nil
+	}
+
+	// Lists can only be looked up once the zone or account is known; the
+	// check runs on a later plan.
+	if !d.NewValueKnown("zone_id") || !d.NewValueKnown("account_id") {
+		return nil
+	}
+
+	var expressions []string
+	for _, rule := range d.Get("rules").([]interface{}) {
+		if rule, ok := rule.(map[string]interface{}); ok {
+			expressions = append(expressions, rule["expression"].(string))
+		}
+	}
+
+	client := meta.(*cloudflare.API)
+
+	return validateExpressionListReferences(ctx, client, d.Get("account_id").(string), d.Get("zone_id").(string), expressions)
+}
+
+func resourceCloudflareRulesetValidateCacheKeyQueryString(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("rules") {
+		return nil
+	}
+
+	for i, rule := range d.Get("rules").([]interface{}) {
+		queryString := nestedListBlock(rule, "action_parameters", "cache_key", "custom_key", "query_string")
+		if queryString == nil {
+			continue
+		}
+
+		if err := validateCacheKeyQueryString(queryString); err != nil {
+			return fmt.Errorf("rules.%d.action_parameters.0.cache_key.0.custom_key.0.query_string: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCloudflareRulesetValidateRateLimit(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("rules") {
+		return nil
+	}
+
+	for i, rule := range d.Get("rules").([]interface{}) {
+		rateLimit := nestedListBlock(rule, "ratelimit")
+		if rateLimit == nil {
+			continue
+		}
+
+		if err := validateRulesetRateLimit(rateLimit); err != nil {
+			return fmt.Errorf("rules.%d.ratelimit: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceCloudflareRulesetValidateLogAction rejects `action_parameters` on
+// rules using the `log` action as it doesn't take any and the API errors
+// otherwise.
+func resourceCloudflareRulesetValidateLogAction(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("rules") {
+		return nil
+	}
+
+	for i, rule := range d.Get("rules").([]interface{}) {
+		if rule, ok := rule.(map[string]interface{}); !ok || rule["action"] != string(cloudflare.RulesetRuleActionLog) {
+			continue
+		}
+
+		if nestedListBlock(rule, "action_parameters") != nil {
+			return fmt.Errorf("rules.%d: `action_parameters` can't be set for the %q action as it doesn't take any parameters", i, cloudflare.RulesetRuleActionLog)
+		}
+	}
+
+	return nil
+}
+
+// resourceCloudflareRulesetValidateServeErrorAction ensures rules using the
+// `serve_error` action configure the response to serve.
+func resourceCloudflareRulesetValidateServeErrorAction(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("rules") {
+		return nil
+	}
+
+	for i, rule := range d.Get("rules").([]interface{}) {
+		if rule, ok := rule.(map[string]interface{}); !ok || rule["action"] != rulesetRuleActionServeError {
+			continue
+		}
+
+		if err := validateRulesetServeErrorParameters(nestedListBlock(rule, "action_parameters")); err != nil {
+			return fmt.Errorf("rules.%d.action_parameters: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateRulesetServeErrorParameters checks that the `action_parameters` of a
+// `serve_error` rule set the content of the error response and its type.
+func validateRulesetServeErrorParameters(actionParameters map[string]interface{}) error {
+	content, _ := actionParameters["content"].(string)
+	contentType, _ := actionParameters["content_type"].(string)
+
+	if content == "" || contentType == "" {
+		return fmt.Errorf("`content` and `content_type` must be set for the %q action", rulesetRuleActionServeError)
+	}
+
+	return nil
+}
+
+// validateRulesetRateLimit checks that a `ratelimit` block tracks requests by
+// at least one characteristic, counts either requests or scores, and that
+// scores are read from a response header.
+func validateRulesetRateLimit(rateLimit map[string]interface{}) error {
+	requestsPerPeriod, _ := rateLimit["requests_per_period"].(int)
+	scorePerPeriod, _ := rateLimit["score_per_period"].(int)
+	scoreHeader, _ := rateLimit["score_response_header_name"].(string)
+
+	var characteristics int
+	switch v := rateLimit["characteristics"].(type) {
+	case *schema.Set:
+		characteristics = v.Len()
+	case []interface{}:
+		characteristics = len(v)
+	}
+
+	switch {
+	case characteristics == 0:
+		return fmt.Errorf("`characteristics` must contain at least one characteristic to track the request rate by, e.g. [\"cf.colo.id\", \"ip.src\"]")
+	case requestsPerPeriod == 0 && scorePerPeriod == 0:
+		return fmt.Errorf("one of `requests_per_period` or `score_per_period` must be set")
+	case requestsPerPeriod > 0 && scorePerPeriod > 0:
+		return fmt.Errorf("only one of `requests_per_period` or `score_per_period` can be set")
+	case scorePerPeriod > 0 && scoreHeader == "":
+		return fmt.Errorf("`score_response_header_name` is required with `score_per_period`")
+	case scorePerPeriod == 0 && scoreHeader != "":
+		return fmt.Errorf("`score_response_header_name` can only be set with `score_per_period`")
+	}
+
+	return nil
+}
+
+// validateCacheKeyQueryString checks that a cache key `query_string` block
+// either includes or excludes parameters, and that `*` isn't combined with
+// named parameters.
+func validateCacheKeyQueryString(queryString map[string]interface{}) error {
+	include, _ := queryString["include"].([]interface{})
+	exclude, _ := queryString["exclude"].([]interface{})
+
+	if len(include) > 0 && len(exclude) > 0 {
+		return fmt.Errorf("only one of `include` or `exclude` can be set")
+	}
+
+	key, params := "include", include
+	if len(exclude) > 0 {
+		key, params = "exclude", exclude
+	}
+	if len(params) > 1 && contains(expandInterfaceToStringList(params), "*") {
+		return fmt.Errorf("`%s` can either be [\"*\"] for all query string parameters or a list of named parameters, not both", key)
+	}
+
+	return nil
+}
+
+// nestedListBlock follows a path of single item list blocks from the given
+// value and returns the last block, or nil if any of them is missing.
+func nestedListBlock(value interface{}, path ...string) map[string]interface{} {
+	block, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, key := range path {
+		list, ok := block[key].([]interface{})
+		if !ok || len(list) == 0 {
+			return nil
+		}
+
+		if block, ok = list[0].(map[string]interface{}); !ok {
+			return nil
+		}
+	}
+
+	return block
+}
+
 func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
@@ -88,7 +437,7 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if len(rules) > 0 {
-		rs.Rules = rules
+		rs.Rules = rulesetRulesToAPI(rules)
 	}
 
 	if sempahoreErr == nil && len(ruleset.Rules) == 0 && ruleset.Description == "" {
@@ -105,8 +454,21 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
+	// Rules using fields cloudflare-go doesn't support yet are sent as a raw
+	// request so those fields aren't dropped.
+	extended := rulesetRulesUseExtendedFields(rules)
+	routeRoot := rulesetRouteRoot(accountID, zoneID)
+
 	var rulesetCreateErr error
-	if accountID != "" {
+	if extended {
+		body := rulesetBody{Ruleset: rs}
+		if len(rules) > 0 {
+			body.Rules = rules
+		}
+		var createdRuleset rulesetBody
+		rulesetCreateErr = rawAPIRequest(ctx, client, http.MethodPost, fmt.Sprintf("/%s/rulesets", routeRoot), body, &createdRuleset)
+		ruleset = createdRuleset.Ruleset
+	} else if accountID != "" {
 		ruleset, rulesetCreateErr = client.CreateAccountRuleset(ctx, accountID, rs)
 	} else {
 		ruleset, rulesetCreateErr = client.CreateZoneRuleset(ctx, zoneID, rs)
@@ -116,18 +478,25 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(fmt.Errorf("error creating ruleset %s: %w", rulesetName, rulesetCreateErr))
 	}
 
-	rulesetEntryPoint := cloudflare.Ruleset{
-		Description: rulesetDescription,
-		Rules:       rules,
-	}
-
 	// For "custom" rulesets, we don't send a follow up PUT it to the entrypoint
 	// endpoint.
 	if rulesetKind != string(cloudflare.RulesetKindCustom) {
-		if accountID != "" {
-			_, err = client.UpdateAccountRulesetPhase(ctx, accountID, rulesetPhase, rulesetEntryPoint)
+		if extended {
+			rulesetEntryPoint := rulesetUpdateRequest{
+				Description: rulesetDescription,
+				Rules:       rules,
+			}
+			err = rawAPIRequest(ctx, client, http.MethodPut, fmt.Sprintf("/%s/rulesets/phases/%s/entrypoint", routeRoot, rulesetPhase), rulesetEntryPoint, nil)
 		} else {
-			_, err = client.UpdateZoneRulesetPhase(ctx, zoneID, rulesetPhase, rulesetEntryPoint)
+			rulesetEntryPoint := cloudflare.Ruleset{
+				Description: rulesetDescription,
+				Rules:       rulesetRulesToAPI(rules),
+			}
+			if accountID != "" {
+				_, err = client.UpdateAccountRulesetPhase(ctx, accountID, rulesetPhase, rulesetEntryPoint)
+			} else {
+				_, err = client.UpdateZoneRulesetPhase(ctx, zoneID, rulesetPhase, rulesetEntryPoint)
+			}
 		}
 
 		if err != nil {
@@ -167,10 +536,23 @@ func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("error reading ruleset ID %q: %w", d.Id(), err))
 	}
 
+	rulesetRules := rulesetRulesFromAPI(ruleset.Rules)
+	if rulesetRulesMayUseExtendedFields(ruleset.Rules) {
+		var extendedRuleset rulesetBody
+		err = rawAPIRequest(ctx, client, http.MethodGet, fmt.Sprintf("/%s/rulesets/%s", rulesetRouteRoot(accountID, zoneID), d.Id()), nil, &extendedRuleset)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading ruleset ID %q: %w", d.Id(), err))
+		}
+		rulesetRules = extendedRuleset.Rules
+	}
+
 	d.Set("name", ruleset.Name)
 	d.Set("description", ruleset.Description)
 
-	if err := d.Set("rules", buildStateFromRulesetRules(ruleset.Rules)); err != nil {
+	priorRules := d.Get("rules").([]interface{})
+
+	rules := buildStateFromRulesetRules(rulesetRules)
+	if err := d.Set("rules", orderRulesetRuleHeadersByState(rules, priorRules)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -188,10 +570,16 @@ func resourceCloudflareRulesetUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	description := d.Get("description").(string)
-	if accountID != "" {
-		_, err = client.UpdateAccountRuleset(ctx, accountID, d.Id(), description, rules)
+	if rulesetRulesUseExtendedFields(rules) {
+		payload := rulesetUpdateRequest{
+			Description: description,
+			Rules:       rules,
+		}
+		err = rawAPIRequest(ctx, client, http.MethodPut, fmt.Sprintf("/%s/rulesets/%s", rulesetRouteRoot(accountID, zoneID), d.Id()), payload, nil)
+	} else if accountID != "" {
+		_, err = client.UpdateAccountRuleset(ctx, accountID, d.Id(), description, rulesetRulesToAPI(rules))
 	} else {
-		_, err = client.UpdateZoneRuleset(ctx, zoneID, d.Id(), description, rules)
+		_, err = client.UpdateZoneRuleset(ctx, zoneID, d.Id(), description, rulesetRulesToAPI(rules))
 	}
 
 	if err != nil {
@@ -222,11 +610,12 @@ func resourceCloudflareRulesetDelete(ctx context.Context, d *schema.ResourceData
 
 // buildStateFromRulesetRules receives the current ruleset rules and returns an
 // interface for the state file.
-func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
+func buildStateFromRulesetRules(rules []rulesetRule) interface{} {
 	var rulesData []map[string]interface{}
 	for _, r := range rules {
 		rule := map[string]interface{}{
 			"id":         r.ID,
+			"ref":        r.Ref,
 			"expression": r.Expression,
 			"action":     r.Action,
 			"enabled":    r.Enabled,
@@ -236,7 +625,10 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 			rule["description"] = r.Description
 		}
 
-		if !reflect.ValueOf(r.ActionParameters).IsNil() {
+		// the `log` action doesn't take any parameters, so anything returned
+		// for it isn't tracked to keep the rule consistent with its
+		// configuration.
+		if !reflect.ValueOf(r.ActionParameters).IsNil() && r.Action != string(cloudflare.RulesetRuleActionLog) {
 			var (
 				actionParameters       []map[string]interface{}
 				overrides              []map[string]interface{}
@@ -255,6 +647,7 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 				serveStaleFields       []map[string]interface{}
 				cacheKeyFields         []map[string]interface{}
 				fromListFields         []map[string]interface{}
+				algorithms             []map[string]interface{}
 			)
 			actionParameterRules := make(map[string]string)
 
@@ -497,6 +890,12 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 				})
 			}
 
+			for _, algorithm := range r.ActionParameters.Algorithms {
+				algorithms = append(algorithms, map[string]interface{}{
+					"name": algorithm.Name,
+				})
+			}
+
 			actionParameters = append(actionParameters, map[string]interface{}{
 				"id":                         r.ActionParameters.ID,
 				"increment":                  r.ActionParameters.Increment,
@@ -524,6 +923,10 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 				"cache_key":                  cacheKeyFields,
 				"origin_error_page_passthru": r.ActionParameters.OriginErrorPagePassthru,
 				"from_list":                  fromListFields,
+				"algorithms":                 algorithms,
+				"content":                    r.ActionParameters.Content,
+				"content_type":               r.ActionParameters.ContentType,
+				"status_code":                r.ActionParameters.StatusCode,
 			})
 
 			rule["action_parameters"] = actionParameters
@@ -533,12 +936,14 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 			var rateLimit []map[string]interface{}
 
 			rateLimit = append(rateLimit, map[string]interface{}{
-				"characteristics":     r.RateLimit.Characteristics,
-				"period":              r.RateLimit.Period,
-				"requests_per_period": r.RateLimit.RequestsPerPeriod,
-				"mitigation_timeout":  r.RateLimit.MitigationTimeout,
-				"counting_expression": r.RateLimit.CountingExpression,
-				"requests_to_origin":  r.RateLimit.RequestsToOrigin,
+				"characteristics":            r.RateLimit.Characteristics,
+				"period":                     r.RateLimit.Period,
+				"requests_per_period":        r.RateLimit.RequestsPerPeriod,
+				"mitigation_timeout":         r.RateLimit.MitigationTimeout,
+				"counting_expression":        r.RateLimit.CountingExpression,
+				"requests_to_origin":         r.RateLimit.RequestsToOrigin,
+				"score_per_period":           r.RateLimit.ScorePerPeriod,
+				"score_response_header_name": r.RateLimit.ScoreResponseHeaderName,
 			})
 
 			rule["ratelimit"] = rateLimit
@@ -572,8 +977,8 @@ func buildStateFromRulesetRules(rules []cloudflare.RulesetRule) interface{} {
 }
 
 // receives the resource config and builds a ruleset rule array.
-func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.RulesetRule, error) {
-	var rulesetRules []cloudflare.RulesetRule
+func buildRulesetRulesFromResource(d *schema.ResourceData) ([]rulesetRule, error) {
+	var rulesetRules []rulesetRule
 
 	rules, ok := d.Get("rules").([]interface{})
 	if !ok {
@@ -581,7 +986,7 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 	}
 
 	for rulesCounter, v := range rules {
-		var rule cloudflare.RulesetRule
+		var rule rulesetRule
 
 		resourceRule, ok := v.(map[string]interface{})
 		if !ok {
@@ -589,7 +994,7 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 		}
 
 		if len(resourceRule["action_parameters"].([]interface{})) > 0 {
-			rule.ActionParameters = &cloudflare.RulesetRuleActionParameters{}
+			rule.ActionParameters = &rulesetRuleActionParameters{}
 			for _, parameter := range resourceRule["action_parameters"].([]interface{}) {
 				for pKey, pValue := range parameter.(map[string]interface{}) {
 					switch pKey {
@@ -1055,6 +1460,22 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 							}
 						}
 
+					case "algorithms":
+						for _, algorithm := range pValue.([]interface{}) {
+							rule.ActionParameters.Algorithms = append(rule.ActionParameters.Algorithms, rulesetRuleActionParametersCompressionAlgorithm{
+								Name: algorithm.(map[string]interface{})["name"].(string),
+							})
+						}
+
+					case "content":
+						rule.ActionParameters.Content = pValue.(string)
+
+					case "content_type":
+						rule.ActionParameters.ContentType = pValue.(string)
+
+					case "status_code":
+						rule.ActionParameters.StatusCode = uint16(pValue.(int))
+
 					default:
 						log.Printf("[DEBUG] unknown key encountered in buildRulesetRulesFromResource for action parameters: %s", pKey)
 					}
@@ -1063,7 +1484,7 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 		}
 
 		if len(resourceRule["ratelimit"].([]interface{})) > 0 {
-			rule.RateLimit = &cloudflare.RulesetRuleRateLimit{}
+			rule.RateLimit = &rulesetRuleRateLimit{}
 			for _, parameter := range resourceRule["ratelimit"].([]interface{}) {
 				for pKey, pValue := range parameter.(map[string]interface{}) {
 					switch pKey {
@@ -1083,6 +1504,10 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 						rule.RateLimit.CountingExpression = pValue.(string)
 					case "requests_to_origin":
 						rule.RateLimit.RequestsToOrigin = pValue.(bool)
+					case "score_per_period":
+						rule.RateLimit.ScorePerPeriod = pValue.(int)
+					case "score_response_header_name":
+						rule.RateLimit.ScoreResponseHeaderName = pValue.(string)
 
 					default:
 						log.Printf("[DEBUG] unknown key encountered in buildRulesetRulesFromResource for ratelimit: %s", pKey)
@@ -1133,12 +1558,111 @@ func buildRulesetRulesFromResource(d *schema.ResourceData) ([]cloudflare.Ruleset
 			rule.Description = resourceRule["description"].(string)
 		}
 
+		// Only send references that are explicitly configured. Computed
+		// references are tied to the position of the rule in state and would
+		// be attached to the wrong rule when rules are added or reordered.
+		if ref := getRawValue(fmt.Sprintf("rules.%d.ref", rulesCounter), d.GetRawConfig()); !ref.IsNull() && ref.IsKnown() {
+			rule.Ref = resourceRule["ref"].(string)
+		}
+
 		rulesetRules = append(rulesetRules, rule)
 	}
 
 	return rulesetRules, nil
 }
 
+// orderRulesetRuleHeadersByState reorders the header operations of each rule to
+// match the order they appear in the prior state. The API keys headers by name
+// so their configured order is lost; without this, any configuration that
+// doesn't list headers alphabetically would show a diff on every plan. Headers
+// that aren't in the prior state keep their sorted position at the end. Rules
+// are matched to the prior state on their `ref` and fall back to position.
+func orderRulesetRuleHeadersByState(rules interface{}, priorRules []interface{}) interface{} {
+	rulesData, ok := rules.([]map[string]interface{})
+	if !ok {
+		return rules
+	}
+
+	priorByRef := make(map[string]interface{}, len(priorRules))
+	for _, prior := range priorRules {
+		if prior, ok := prior.(map[string]interface{}); ok {
+			if ref, _ := prior["ref"].(string); ref != "" {
+				priorByRef[ref] = prior
+			}
+		}
+	}
+
+	for i, rule := range rulesData {
+		var priorRule interface{}
+		if ref, _ := rule["ref"].(string); ref != "" && priorByRef[ref] != nil {
+			priorRule = priorByRef[ref]
+		} else if len(rulesData) == len(priorRules) {
+			priorRule = priorRules[i]
+		}
+
+		headers := rulesetRuleHeaders(rule)
+		priorHeaders := rulesetRuleHeaders(priorRule)
+		if len(headers) < 2 || len(priorHeaders) == 0 {
+			continue
+		}
+
+		position := make(map[string]int, len(priorHeaders))
+		for j, header := range priorHeaders {
+			position[header["name"].(string)] = j
+		}
+
+		sort.SliceStable(headers, func(a, b int) bool {
+			posA, okA := position[headers[a]["name"].(string)]
+			posB, okB := position[headers[b]["name"].(string)]
+			if okA && okB {
+				return posA < posB
+			}
+			return okA && !okB
+		})
+	}
+
+	return rulesData
+}
+
+// rulesetRuleHeaders returns the header operations of a single rule in either
+// the state built by buildStateFromRulesetRules or the one read from the
+// resource data.
+func rulesetRuleHeaders(rule interface{}) []map[string]interface{} {
+	ruleData, ok := rule.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	var actionParameters map[string]interface{}
+	switch ap := ruleData["action_parameters"].(type) {
+	case []map[string]interface{}:
+		if len(ap) > 0 {
+			actionParameters = ap[0]
+		}
+	case []interface{}:
+		if len(ap) > 0 {
+			actionParameters, _ = ap[0].(map[string]interface{})
+		}
+	}
+	if actionParameters == nil {
+		return nil
+	}
+
+	var headers []map[string]interface{}
+	switch h := actionParameters["headers"].(type) {
+	case []map[string]interface{}:
+		headers = h
+	case []interface{}:
+		for _, header := range h {
+			if header, ok := header.(map[string]interface{}); ok {
+				headers = append(headers, header)
+			}
+		}
+	}
+
+	return headers
+}
+
 // statusToAPIEnabledFieldConversion takes the "status" field from the Terraform
 // schema/state and converts it to the API equivalent for the "enabled" field.
 func statusToAPIEnabledFieldConversion(s string) *bool {
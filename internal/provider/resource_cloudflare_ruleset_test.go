@@ -2,13 +2,20 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
 )
 
@@ -776,6 +783,89 @@ func TestAccCloudflareRuleset_RateLimit(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRuleset_RateLimitOriginCounting(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetRateLimitCounting(rnd, zoneID, `
+        period = 60
+        requests_per_period = 100
+        mitigation_timeout = 600
+        counting_expression = "(http.response.code eq 401)"
+        requests_to_origin = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.characteristics.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.requests_per_period", "100"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.mitigation_timeout", "600"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.counting_expression", "(http.response.code eq 401)"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.requests_to_origin", "true"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareRulesetRateLimitCounting(rnd, zoneID, `
+        period = 60
+        score_per_period = 400
+        score_response_header_name = "my-score"
+        mitigation_timeout = 600
+        requests_to_origin = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.score_per_period", "400"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.score_response_header_name", "my-score"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.ratelimit.0.requests_per_period", "0"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareRulesetRateLimitCounting(rnd, zoneID, `
+        period = 60
+        requests_per_period = 100
+        score_per_period = 400
+        score_response_header_name = "my-score"`),
+				ExpectError: regexp.MustCompile("only one of `requests_per_period` or `score_per_period` can be set"),
+			},
+			{
+				Config: testAccCheckCloudflareRulesetRateLimitCounting(rnd, zoneID, `
+        period = 5
+        requests_per_period = 100`),
+				ExpectError: regexp.MustCompile(`expected rules.0.ratelimit.0.period to be in the range \(10 - 86400\), got 5`),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRuleset_LogActionWithActionParameters(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareRulesetLogActionWithActionParameters(rnd, zoneID),
+				ExpectError: regexp.MustCompile("`action_parameters` can't be set for the \"log\" action"),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRuleset_RequestOrigin(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
 	// service does not yet support the API tokens and it results in
@@ -1082,6 +1172,53 @@ func TestAccCloudflareRuleset_TransformationRuleResponseHeaders(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRuleset_TransformationRuleHeadersKeepConfiguredOrder(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetTransformationRuleHeadersUnsorted(rnd, "transform rule for unsorted headers", zoneID, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_request_late_transform"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.#", "3"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.0.name", "x-zebra"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.0.operation", "set"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.0.value", "stripes"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.1.name", "x-apple"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.1.operation", "remove"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.2.name", "x-mango"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.2.operation", "set"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.headers.2.expression", "cf.zone.name"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareRulesetTransformationRuleHeadersUnsorted(rnd, "transform rule for unsorted headers", zoneID, zoneName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRuleset_ActionParametersMultipleSkips(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
 	// service does not yet support the API tokens and it results in
@@ -1263,6 +1400,350 @@ func TestAccCloudflareRuleset_AccountLevelCustomWAFRule(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRuleset_AccountLevelManagedWAFOWASP(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetAccountLevelManagedWAFOWASP(rnd, "account level OWASP managed ruleset", accountID, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "kind", "root"),
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_request_firewall_managed"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "execute"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.id", "4814384a9e5d4991b9815dcfc25d2f1f"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.categories.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.categories.0.category", "paranoia-level-3"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.categories.0.status", "disabled"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.categories.1.category", "paranoia-level-4"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.categories.1.status", "disabled"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.rules.0.id", "6179ae15870a4bb7b2d480d4843b323c"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.rules.0.action", "log"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.overrides.0.rules.0.score_threshold", "40"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRuleset_CompressionRules(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetCompressionRules(rnd, "my basic compression ruleset", zoneID, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "my basic compression ruleset"),
+					resource.TestCheckResourceAttr(resourceName, "kind", "zone"),
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_response_compression"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "compress_response"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.algorithms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.algorithms.0.name", "brotli"),
+				),
+			},
+		},
+	})
+}
+
+func TestRulesetCreateSendsCompressionAlgorithms(t *testing.T) {
+	var entrypoint rulesetUpdateRequest
+	rulesetsURL := "/zones/" + testAccCloudflareZoneID + "/rulesets"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(rulesetsURL+"/phases/http_response_compression/entrypoint", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10003, "message": "could not find entrypoint ruleset in the http_response_compression phase"}], "messages": [], "result": null}`)
+			return
+		}
+
+		if !decodeTestRequestBody(t, w, r, &entrypoint) {
+			return
+		}
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "70339d97bdb34195bbf054b1ebe81f76"}}`)
+	})
+	mux.HandleFunc(rulesetsURL, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "70339d97bdb34195bbf054b1ebe81f76"}}`)
+	})
+	mux.HandleFunc(rulesetsURL+"/70339d97bdb34195bbf054b1ebe81f76", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+			"id": "70339d97bdb34195bbf054b1ebe81f76",
+			"name": "compression",
+			"kind": "zone",
+			"phase": "http_response_compression",
+			"rules": [
+				{"id": "3a03d665bac047339bb530ecb439a90d", "action": "compress_response", "action_parameters": {"algorithms": [{"name": "brotli"}, {"name": "auto"}]}, "expression": "true", "enabled": true}
+			]
+		}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareRulesetSchema(), map[string]interface{}{
+		"zone_id": testAccCloudflareZoneID,
+		"name":    "compression",
+		"kind":    "zone",
+		"phase":   "http_response_compression",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"action":     "compress_response",
+				"expression": "true",
+				"enabled":    true,
+				"action_parameters": []interface{}{map[string]interface{}{
+					"algorithms": []interface{}{
+						map[string]interface{}{"name": "brotli"},
+						map[string]interface{}{"name": "auto"},
+					},
+				}},
+			},
+		},
+	})
+
+	if diags := resourceCloudflareRulesetCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error creating ruleset: %v", diags)
+	}
+
+	if len(entrypoint.Rules) != 1 || entrypoint.Rules[0].ActionParameters == nil {
+		t.Fatalf("expected the entrypoint to be updated with the compression rule, got %+v", entrypoint)
+	}
+
+	if algorithms := entrypoint.Rules[0].ActionParameters.Algorithms; len(algorithms) != 2 || algorithms[0].Name != "brotli" || algorithms[1].Name != "auto" {
+		t.Errorf("expected brotli and auto algorithms to be sent in order, got %+v", algorithms)
+	}
+
+	for i, name := range []string{"brotli", "auto"} {
+		if got := d.Get(fmt.Sprintf("rules.0.action_parameters.0.algorithms.%d.name", i)).(string); got != name {
+			t.Errorf("expected algorithm %d to be read back as %q, got %q", i, name, got)
+		}
+	}
+}
+
+func TestRulesetReadOnlyRequestsExtendedFieldsWhenUsed(t *testing.T) {
+	testCases := map[string]struct {
+		rule             string
+		expectedRequests int
+	}{
+		"rule supported by cloudflare-go": {
+			rule:             `{"id": "3a03d665bac047339bb530ecb439a90d", "action": "block", "expression": "(cf.client.bot)", "enabled": true}`,
+			expectedRequests: 1,
+		},
+		"compress_response rule": {
+			rule:             `{"id": "3a03d665bac047339bb530ecb439a90d", "action": "compress_response", "action_parameters": {"algorithms": [{"name": "gzip"}]}, "expression": "true", "enabled": true}`,
+			expectedRequests: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/rulesets/70339d97bdb34195bbf054b1ebe81f76", func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("content-type", "application/json")
+				fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {
+					"id": "70339d97bdb34195bbf054b1ebe81f76",
+					"name": "example",
+					"kind": "zone",
+					"phase": "http_request_firewall_custom",
+					"rules": [%s]
+				}}`, tc.rule)
+			})
+
+			client := newTestAPIClient(t, mux)
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareRulesetSchema(), map[string]interface{}{
+				"zone_id": testAccCloudflareZoneID,
+				"name":    "example",
+				"kind":    "zone",
+				"phase":   "http_request_firewall_custom",
+			})
+			d.SetId("70339d97bdb34195bbf054b1ebe81f76")
+
+			if diags := resourceCloudflareRulesetRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error reading ruleset: %v", diags)
+			}
+
+			if requests != tc.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tc.expectedRequests, requests)
+			}
+		})
+	}
+}
+
+func TestAccCloudflareRuleset_CustomErrors(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetCustomErrors(rnd, "my basic custom errors ruleset", zoneID, zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "my basic custom errors ruleset"),
+					resource.TestCheckResourceAttr(resourceName, "kind", "zone"),
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_custom_errors"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "serve_error"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.content", "<html><body><h1>Access denied</h1></body></html>"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.content_type", "text/html"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.status_code", "403"),
+				),
+			},
+		},
+	})
+}
+
+func TestRulesetServeErrorActionValidation(t *testing.T) {
+	testCases := map[string]struct {
+		actionParameters map[string]interface{}
+		err              string
+	}{
+		"content and content type": {
+			actionParameters: map[string]interface{}{"content": "denied", "content_type": "text/plain", "status_code": 403},
+		},
+		"without status code": {
+			actionParameters: map[string]interface{}{"content": `{"error": "denied"}`, "content_type": "application/json"},
+		},
+		"without content": {
+			actionParameters: map[string]interface{}{"content_type": "text/plain", "status_code": 403},
+			err:              "rules.0.action_parameters: `content` and `content_type` must be set for the \"serve_error\" action",
+		},
+		"without content type": {
+			actionParameters: map[string]interface{}{"content": "denied"},
+			err:              "rules.0.action_parameters: `content` and `content_type` must be set for the \"serve_error\" action",
+		},
+		"unknown content type": {
+			actionParameters: map[string]interface{}{"content": "denied", "content_type": "image/png"},
+			err:              "expected rules.0.action_parameters.0.content_type to be one of",
+		},
+		"status code out of range": {
+			actionParameters: map[string]interface{}{"content": "denied", "content_type": "text/plain", "status_code": 200},
+			err:              "expected rules.0.action_parameters.0.status_code to be in the range (400 - 999)",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"zone_id": testAccCloudflareZoneID,
+				"name":    "custom errors",
+				"kind":    "zone",
+				"phase":   "http_custom_errors",
+				"rules": []interface{}{map[string]interface{}{
+					"action":            "serve_error",
+					"expression":        "http.response.code eq 403",
+					"action_parameters": []interface{}{tc.actionParameters},
+				}},
+			})
+
+			var err error
+			if diags := resourceCloudflareRuleset().Validate(config); diags.HasError() {
+				err = fmt.Errorf("%s", diags[0].Summary)
+			} else {
+				_, err = resourceCloudflareRuleset().Diff(context.Background(), nil, config, nil)
+			}
+
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestRulesetServeErrorParametersRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareRulesetSchema(), map[string]interface{}{
+		"zone_id": testAccCloudflareZoneID,
+		"name":    "custom errors",
+		"kind":    "zone",
+		"phase":   "http_custom_errors",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"action":     "serve_error",
+				"expression": "http.response.code eq 403",
+				"enabled":    true,
+				"action_parameters": []interface{}{map[string]interface{}{
+					"content":      "denied",
+					"content_type": "text/plain",
+					"status_code":  403,
+				}},
+			},
+		},
+	})
+
+	rules, err := buildRulesetRulesFromResource(d)
+	if err != nil {
+		t.Fatalf("unexpected error building rules: %s", err)
+	}
+
+	body, err := json.Marshal(rules[0])
+	if err != nil {
+		t.Fatalf("error marshalling rule: %s", err)
+	}
+
+	var sent struct {
+		ActionParameters map[string]interface{} `json:"action_parameters"`
+	}
+	if err := json.Unmarshal(body, &sent); err != nil {
+		t.Fatalf("error unmarshalling rule: %s", err)
+	}
+
+	want := map[string]interface{}{"content": "denied", "content_type": "text/plain", "status_code": float64(403)}
+	if !reflect.DeepEqual(sent.ActionParameters, want) {
+		t.Errorf("expected action parameters %v to be sent, got %v", want, sent.ActionParameters)
+	}
+
+	state := buildStateFromRulesetRules(rules)
+	actionParameters := state.([]map[string]interface{})[0]["action_parameters"].([]map[string]interface{})[0]
+	if actionParameters["content"] != "denied" || actionParameters["content_type"] != "text/plain" || actionParameters["status_code"] != uint16(403) {
+		t.Errorf("expected the serve_error parameters to be read back, got %v", actionParameters)
+	}
+}
+
 func TestAccCloudflareRuleset_ExposedCredentialCheck(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
 	// service does not yet support the API tokens and it results in
@@ -1683,6 +2164,45 @@ func TestAccCloudflareRuleset_CacheSettings(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRuleset_CacheKeyResolvedHost(t *testing.T) {
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRulesetCacheKeyQueryString(rnd, zoneID, `include = ["page", "lang"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_key.0.custom_key.0.host.0.resolved", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_key.0.custom_key.0.query_string.0.include.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_key.0.custom_key.0.query_string.0.include.0", "page"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_key.0.custom_key.0.query_string.0.include.1", "lang"),
+				),
+			},
+			{
+				Config: testAccCloudflareRulesetCacheKeyQueryString(rnd, zoneID, `include = ["*"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_key.0.custom_key.0.host.0.resolved", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_key.0.custom_key.0.query_string.0.include.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.cache_key.0.custom_key.0.query_string.0.include.0", "*"),
+				),
+			},
+			{
+				Config:      testAccCloudflareRulesetCacheKeyQueryString(rnd, zoneID, "include = [\"page\"]\nexclude = [\"utm_source\"]"),
+				ExpectError: regexp.MustCompile("only one of `include` or `exclude` can be set"),
+			},
+			{
+				Config:      testAccCloudflareRulesetCacheKeyQueryString(rnd, zoneID, `exclude = ["*", "utm_source"]`),
+				ExpectError: regexp.MustCompile("`exclude` can either be"),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRuleset_Redirect(t *testing.T) {
 	t.Parallel()
 	rnd := generateRandomResourceName()
@@ -2317,6 +2837,43 @@ func testAccCheckCloudflareRulesetTransformationRuleResponseHeaders(rnd, name, z
   }`, rnd, name, zoneID, zoneName)
 }
 
+func testAccCheckCloudflareRulesetTransformationRuleHeadersUnsorted(rnd, name, zoneID, zoneName string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_request_late_transform"
+
+    rules {
+      action = "rewrite"
+      action_parameters {
+        headers {
+          name      = "x-zebra"
+          operation = "set"
+          value     = "stripes"
+        }
+
+        headers {
+          name      = "x-apple"
+          operation = "remove"
+        }
+
+        headers {
+          name       = "x-mango"
+          operation  = "set"
+          expression = "cf.zone.name"
+        }
+      }
+
+      expression = "true"
+      description = "example unsorted header transformation rule"
+      enabled = false
+    }
+  }`, rnd, name, zoneID, zoneName)
+}
+
 func testAccCheckCloudflareRulesetManagedWAFPayloadLogging(rnd, name, zoneID, zoneName string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ruleset" "%[1]s" {
@@ -2400,6 +2957,56 @@ func testAccCheckCloudflareRulesetRateLimit(rnd, name, zoneID, zoneName string)
   }`, rnd, name, zoneID, zoneName)
 }
 
+func testAccCheckCloudflareRulesetRateLimitCounting(rnd, zoneID, rateLimit string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_ratelimit"
+
+    rules {
+      action = "block"
+      ratelimit {
+        characteristics = [
+          "cf.colo.id",
+          "ip.src"
+        ]
+        %[3]s
+      }
+      expression = "(http.request.uri.path matches \"^/login\")"
+      description = "origin counting rate limit"
+      enabled = true
+    }
+  }`, rnd, zoneID, rateLimit)
+}
+
+func testAccCheckCloudflareRulesetLogActionWithActionParameters(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_request_firewall_custom"
+
+    rules {
+      action = "log"
+      action_parameters {
+        response {
+          status_code  = 403
+          content      = "blocked"
+          content_type = "text/plain"
+        }
+      }
+      expression  = "(http.request.uri.path matches \"^/admin\")"
+      description = "log admin requests"
+      enabled     = true
+    }
+  }`, rnd, zoneID)
+}
+
 func testAccCheckCloudflareRulesetActionParametersOverridesActionEnabled(rnd, name, zoneID, zoneName string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ruleset" "%[1]s" {
@@ -2557,6 +3164,92 @@ func testAccCheckCloudflareRulesetAccountLevelCustomWAFRule(rnd, name, accountID
   }`, rnd, name, accountID, zoneName)
 }
 
+func testAccCheckCloudflareRulesetAccountLevelManagedWAFOWASP(rnd, name, accountID, zoneName string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    account_id  = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "root"
+    phase       = "http_request_firewall_managed"
+
+    # run PL1 and PL2 with a medium anomaly score threshold
+    rules {
+      action = "execute"
+      action_parameters {
+        id = "4814384a9e5d4991b9815dcfc25d2f1f"
+        overrides {
+          categories {
+            category = "paranoia-level-3"
+            status = "disabled"
+          }
+
+          categories {
+            category = "paranoia-level-4"
+            status = "disabled"
+          }
+
+          rules {
+            id = "6179ae15870a4bb7b2d480d4843b323c"
+            action = "log"
+            score_threshold = 40
+            status = "enabled"
+          }
+        }
+      }
+      expression = "(cf.zone.name eq \"%[4]s\")"
+      description = "OWASP for %[4]s"
+      enabled = true
+    }
+  }`, rnd, name, accountID, zoneName)
+}
+
+func testAccCheckCloudflareRulesetCompressionRules(rnd, name, zoneID, zoneName string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_response_compression"
+
+    rules {
+      action = "compress_response"
+      action_parameters {
+        algorithms {
+          name = "brotli"
+        }
+      }
+      expression  = "(http.host eq \"%[4]s\" and starts_with(http.request.uri.path, \"/assets/\"))"
+      description = "force brotli for assets"
+      enabled     = true
+    }
+  }`, rnd, name, zoneID, zoneName)
+}
+
+func testAccCheckCloudflareRulesetCustomErrors(rnd, name, zoneID, zoneName string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[3]s"
+    name        = "%[2]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_custom_errors"
+
+    rules {
+      action = "serve_error"
+      action_parameters {
+        content      = "<html><body><h1>Access denied</h1></body></html>"
+        content_type = "text/html"
+        status_code  = 403
+      }
+      expression  = "(http.host eq \"%[4]s\" and http.response.code eq 403)"
+      description = "serve a custom 403 page"
+      enabled     = true
+    }
+  }`, rnd, name, zoneID, zoneName)
+}
+
 func testAccCheckCloudflareRulesetTransformationRuleURIPathAndQueryCombination(rnd, name, zoneID, zoneName string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ruleset" "%[1]s" {
@@ -2903,6 +3596,36 @@ func testAccCloudflareRulesetCacheSettingsAllEnabled(rnd, accountID, zoneID stri
   }`, rnd, accountID, zoneID)
 }
 
+func testAccCloudflareRulesetCacheKeyQueryString(rnd, zoneID, queryString string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_request_cache_settings"
+
+    rules {
+      action = "set_cache_settings"
+      action_parameters {
+        cache_key {
+          custom_key {
+            query_string {
+              %[3]s
+            }
+            host {
+              resolved = true
+            }
+          }
+        }
+      }
+      expression  = "true"
+      description = "%[1]s cache key rule"
+      enabled     = true
+    }
+  }`, rnd, zoneID, queryString)
+}
+
 func testAccCloudflareRulesetCacheSettingsOptionalsEmpty(rnd, accountID, zoneID string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ruleset" "%[1]s" {
@@ -2991,3 +3714,395 @@ func testAccCloudflareRulesetRedirectFromList(rnd, accountID string) string {
     }
   }`, rnd, accountID)
 }
+
+func TestRulesetReadKeepsAPIRuleOrder(t *testing.T) {
+	testCases := map[string]struct {
+		stateRefs    []interface{}
+		expectedRefs []string
+	}{
+		"rules reordered out of band with refs": {
+			stateRefs:    []interface{}{"block_bots", "challenge_admin"},
+			expectedRefs: []string{"challenge_admin", "block_bots"},
+		},
+		"rules without refs in state": {
+			stateRefs:    []interface{}{"", ""},
+			expectedRefs: []string{"challenge_admin", "block_bots"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/rulesets/70339d97bdb34195bbf054b1ebe81f76", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("content-type", "application/json")
+				fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+					"id": "70339d97bdb34195bbf054b1ebe81f76",
+					"name": "example",
+					"kind": "zone",
+					"phase": "http_request_firewall_custom",
+					"rules": [
+						{"id": "3a03d665bac047339bb530ecb439a90d", "ref": "challenge_admin", "action": "challenge", "expression": "(http.request.uri.path contains \"/admin\")", "enabled": true},
+						{"id": "1cf8e4ea8b5b4b4c9e5a0f5bc0b0d6b1", "ref": "block_bots", "action": "block", "expression": "(cf.client.bot)", "enabled": true}
+					]
+				}}`)
+			})
+
+			client := newTestAPIClient(t, mux)
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareRulesetSchema(), map[string]interface{}{
+				"zone_id": testAccCloudflareZoneID,
+				"name":    "example",
+				"kind":    "zone",
+				"phase":   "http_request_firewall_custom",
+				"rules": []interface{}{
+					map[string]interface{}{"ref": tc.stateRefs[0], "action": "block", "expression": "(cf.client.bot)", "enabled": true},
+					map[string]interface{}{"ref": tc.stateRefs[1], "action": "challenge", "expression": "(http.request.uri.path contains \"/admin\")", "enabled": true},
+				},
+			})
+			d.SetId("70339d97bdb34195bbf054b1ebe81f76")
+
+			if diags := resourceCloudflareRulesetRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error reading ruleset: %v", diags)
+			}
+
+			for i, ref := range tc.expectedRefs {
+				if got := d.Get(fmt.Sprintf("rules.%d.ref", i)).(string); got != ref {
+					t.Errorf("expected rule %d to have ref %q, got %q", i, ref, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRulesetReadKeepsHeaderOrderFromState(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/rulesets/70339d97bdb34195bbf054b1ebe81f76", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+			"id": "70339d97bdb34195bbf054b1ebe81f76",
+			"name": "example",
+			"kind": "zone",
+			"phase": "http_request_late_transform",
+			"rules": [
+				{
+					"id": "3a03d665bac047339bb530ecb439a90d",
+					"action": "rewrite",
+					"expression": "true",
+					"enabled": true,
+					"action_parameters": {
+						"headers": {
+							"x-apple": {"operation": "remove"},
+							"x-mango": {"operation": "set", "expression": "cf.zone.name"},
+							"x-new": {"operation": "set", "value": "added-elsewhere"},
+							"x-zebra": {"operation": "set", "value": "stripes"}
+						}
+					}
+				}
+			]
+		}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareRulesetSchema(), map[string]interface{}{
+		"zone_id": testAccCloudflareZoneID,
+		"name":    "example",
+		"kind":    "zone",
+		"phase":   "http_request_late_transform",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"action":     "rewrite",
+				"expression": "true",
+				"enabled":    true,
+				"action_parameters": []interface{}{
+					map[string]interface{}{
+						"headers": []interface{}{
+							map[string]interface{}{"name": "x-zebra", "operation": "set", "value": "stripes"},
+							map[string]interface{}{"name": "x-apple", "operation": "remove"},
+							map[string]interface{}{"name": "x-mango", "operation": "set", "expression": "cf.zone.name"},
+						},
+					},
+				},
+			},
+		},
+	})
+	d.SetId("70339d97bdb34195bbf054b1ebe81f76")
+
+	if diags := resourceCloudflareRulesetRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error reading ruleset: %v", diags)
+	}
+
+	expected := []struct{ name, operation string }{
+		{"x-zebra", "set"},
+		{"x-apple", "remove"},
+		{"x-mango", "set"},
+		{"x-new", "set"},
+	}
+	if got := d.Get("rules.0.action_parameters.0.headers.#").(int); got != len(expected) {
+		t.Fatalf("expected %d headers, got %d", len(expected), got)
+	}
+	for i, header := range expected {
+		if got := d.Get(fmt.Sprintf("rules.0.action_parameters.0.headers.%d.name", i)).(string); got != header.name {
+			t.Errorf("expected header %d to be %q, got %q", i, header.name, got)
+		}
+		if got := d.Get(fmt.Sprintf("rules.0.action_parameters.0.headers.%d.operation", i)).(string); got != header.operation {
+			t.Errorf("expected header %d to have operation %q, got %q", i, header.operation, got)
+		}
+	}
+}
+
+func TestValidateCacheKeyQueryString(t *testing.T) {
+	testCases := map[string]struct {
+		queryString map[string]interface{}
+		err         string
+	}{
+		"named parameters are included": {
+			queryString: map[string]interface{}{"include": []interface{}{"page", "lang"}, "exclude": []interface{}{}},
+		},
+		"all parameters are excluded": {
+			queryString: map[string]interface{}{"include": []interface{}{}, "exclude": []interface{}{"*"}},
+		},
+		"include and exclude are both set": {
+			queryString: map[string]interface{}{"include": []interface{}{"page"}, "exclude": []interface{}{"utm_source"}},
+			err:         "only one of `include` or `exclude` can be set",
+		},
+		"all is combined with named parameters": {
+			queryString: map[string]interface{}{"include": []interface{}{"*", "page"}},
+			err:         "`include` can either be",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateCacheKeyQueryString(tc.queryString)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestValidateRulesetRateLimit(t *testing.T) {
+	characteristics := []interface{}{"cf.colo.id", "ip.src"}
+
+	testCases := map[string]struct {
+		rateLimit map[string]interface{}
+		err       string
+	}{
+		"requests are counted": {
+			rateLimit: map[string]interface{}{"characteristics": characteristics, "requests_per_period": 100, "score_per_period": 0, "score_response_header_name": ""},
+		},
+		"scores are counted": {
+			rateLimit: map[string]interface{}{"characteristics": characteristics, "requests_per_period": 0, "score_per_period": 400, "score_response_header_name": "my-score"},
+		},
+		"characteristics from the schema": {
+			rateLimit: map[string]interface{}{"characteristics": schema.NewSet(schema.HashString, characteristics), "requests_per_period": 100},
+		},
+		"without characteristics": {
+			rateLimit: map[string]interface{}{"characteristics": schema.NewSet(schema.HashString, nil), "requests_per_period": 100},
+			err:       "`characteristics` must contain at least one characteristic to track the request rate by, e.g. [\"cf.colo.id\", \"ip.src\"]",
+		},
+		"nothing is counted": {
+			rateLimit: map[string]interface{}{"characteristics": characteristics, "requests_per_period": 0, "score_per_period": 0, "score_response_header_name": ""},
+			err:       "one of `requests_per_period` or `score_per_period` must be set",
+		},
+		"requests and scores are counted": {
+			rateLimit: map[string]interface{}{"characteristics": characteristics, "requests_per_period": 100, "score_per_period": 400, "score_response_header_name": "my-score"},
+			err:       "only one of `requests_per_period` or `score_per_period` can be set",
+		},
+		"scores without a header": {
+			rateLimit: map[string]interface{}{"characteristics": characteristics, "requests_per_period": 0, "score_per_period": 400, "score_response_header_name": ""},
+			err:       "`score_response_header_name` is required with `score_per_period`",
+		},
+		"header without scores": {
+			rateLimit: map[string]interface{}{"characteristics": characteristics, "requests_per_period": 100, "score_per_period": 0, "score_response_header_name": "my-score"},
+			err:       "`score_response_header_name` can only be set with `score_per_period`",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateRulesetRateLimit(tc.rateLimit)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tc.err {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestRulesetRateLimitWithoutCharacteristicsIsRejected(t *testing.T) {
+	_, err := resourceCloudflareRuleset().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id": testAccCloudflareZoneID,
+		"name":    "rate limit",
+		"kind":    "zone",
+		"phase":   "http_ratelimit",
+		"rules": []interface{}{map[string]interface{}{
+			"action":     "block",
+			"expression": "true",
+			"ratelimit": []interface{}{map[string]interface{}{
+				"period":              60,
+				"requests_per_period": 100,
+			}},
+		}},
+	}), nil)
+
+	expected := "rules.0.ratelimit: `characteristics` must contain at least one characteristic"
+	if err == nil || !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected error containing %q, got %v", expected, err)
+	}
+}
+
+func TestRulesetValidateListReferencesSkipsUnknownZone(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request to %s while the zone is unknown", r.Method, r.URL.Path)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	// Terraform's marker for values that are not known until apply.
+	unknown := "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	_, err := resourceCloudflareRuleset().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id":                  unknown,
+		"name":                     "block offices",
+		"kind":                     "zone",
+		"phase":                    "http_request_firewall_custom",
+		"validate_list_references": true,
+		"rules": []interface{}{map[string]interface{}{
+			"action":     "block",
+			"expression": "ip.src in $office_ips",
+		}},
+	}), client)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestRulesetRateLimitScoreRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareRulesetSchema(), map[string]interface{}{
+		"zone_id": testAccCloudflareZoneID,
+		"name":    "rate limit",
+		"kind":    "zone",
+		"phase":   "http_ratelimit",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"action":     "block",
+				"expression": "true",
+				"enabled":    true,
+				"ratelimit": []interface{}{map[string]interface{}{
+					"characteristics":            []interface{}{"cf.colo.id", "ip.src"},
+					"period":                     60,
+					"score_per_period":           400,
+					"score_response_header_name": "my-score",
+					"requests_to_origin":         true,
+				}},
+			},
+		},
+	})
+
+	rules, err := buildRulesetRulesFromResource(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	body, err := json.Marshal(rules[0])
+	if err != nil {
+		t.Fatalf("error encoding rule: %s", err)
+	}
+	for _, field := range []string{`"score_per_period":400`, `"score_response_header_name":"my-score"`, `"requests_to_origin":true`} {
+		if !strings.Contains(string(body), field) {
+			t.Errorf("expected %s to be sent, got %s", field, body)
+		}
+	}
+
+	var sent rulesetRule
+	if err := json.Unmarshal(body, &sent); err != nil {
+		t.Fatalf("error decoding rule: %s", err)
+	}
+	state := buildStateFromRulesetRules([]rulesetRule{sent}).([]map[string]interface{})
+	rateLimit := state[0]["ratelimit"].([]map[string]interface{})[0]
+	if rateLimit["score_per_period"] != 400 || rateLimit["score_response_header_name"] != "my-score" {
+		t.Errorf("expected score settings to be read back, got %#v", rateLimit)
+	}
+}
+
+func TestRulesetLogActionRejectsActionParameters(t *testing.T) {
+	testCases := map[string]struct {
+		rule map[string]interface{}
+		err  string
+	}{
+		"log without parameters": {
+			rule: map[string]interface{}{"action": "log", "expression": "true"},
+		},
+		"log with parameters": {
+			rule: map[string]interface{}{
+				"action":            "log",
+				"expression":        "true",
+				"action_parameters": []interface{}{map[string]interface{}{"version": "latest"}},
+			},
+			err: "rules.0: `action_parameters` can't be set for the \"log\" action as it doesn't take any parameters",
+		},
+		"other action with parameters": {
+			rule: map[string]interface{}{
+				"action":            "execute",
+				"expression":        "true",
+				"action_parameters": []interface{}{map[string]interface{}{"id": "efb7b8c949ac4650a09736fc376e9aee"}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := resourceCloudflareRuleset().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"zone_id": testAccCloudflareZoneID,
+				"name":    "log",
+				"kind":    "zone",
+				"phase":   "http_request_firewall_custom",
+				"rules":   []interface{}{tc.rule},
+			}), nil)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestRulesetReadIgnoresLogActionParameters(t *testing.T) {
+	var rule rulesetRule
+	if err := json.Unmarshal([]byte(`{
+		"id": "3a03d665bac047339bb530ecb439a90d",
+		"action": "log",
+		"action_parameters": {},
+		"expression": "true",
+		"enabled": true
+	}`), &rule); err != nil {
+		t.Fatalf("error decoding rule: %s", err)
+	}
+
+	state := buildStateFromRulesetRules([]rulesetRule{rule}).([]map[string]interface{})
+	if _, ok := state[0]["action_parameters"]; ok {
+		t.Errorf("expected no action_parameters for a log rule, got %#v", state[0]["action_parameters"])
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -22,9 +23,32 @@ func resourceCloudflareTeamsAccount() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareTeamsAccountImport,
 		},
+		CustomizeDiff: resourceCloudflareTeamsAccountValidateCustomCertificate,
 	}
 }
 
+// teamsConfiguration holds the Teams configuration settings cloudflare-go
+// doesn't support yet. They're read and written with raw API requests
+// alongside the cloudflare-go configuration.
+type teamsConfiguration struct {
+	Settings teamsAccountSettings `json:"settings"`
+}
+
+type teamsAccountSettings struct {
+	CustomCertificate *teamsCustomCertificate `json:"custom_certificate,omitempty"`
+}
+
+// teamsCustomCertificate selects the certificate Gateway uses to inspect TLS
+// traffic in place of the Cloudflare managed one.
+type teamsCustomCertificate struct {
+	Enabled bool   `json:"enabled"`
+	ID      string `json:"id,omitempty"`
+}
+
+func teamsConfigurationURI(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/gateway/configuration", accountID)
+}
+
 func resourceCloudflareTeamsAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
@@ -73,6 +97,15 @@ func resourceCloudflareTeamsAccountRead(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	var customCertificate *teamsCustomCertificate
+	if err := rawAPIRequest(ctx, client, http.MethodGet, teamsConfigurationURI(accountID)+"/custom_certificate", nil, &customCertificate); err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Teams Account custom certificate %q: %w", d.Id(), err))
+	}
+
+	if err := d.Set("custom_certificate", flattenTeamsCustomCertificate(customCertificate)); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing account custom certificate config: %w", err))
+	}
+
 	logSettings, err := client.TeamsAccountLoggingConfiguration(ctx, accountID)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error finding Teams Account log settings %q: %w", d.Id(), err))
@@ -104,6 +137,7 @@ func resourceCloudflareTeamsAccountUpdate(ctx context.Context, d *schema.Resourc
 	antivirusConfig := inflateAntivirusConfig(d.Get("antivirus"))
 	loggingConfig := inflateLoggingSettings(d.Get("logging"))
 	deviceConfig := inflateDeviceSettings(d.Get("proxy"))
+	customCertificateConfig := inflateTeamsCustomCertificate(d.Get("custom_certificate"))
 	updatedTeamsAccount := cloudflare.TeamsConfiguration{
 		Settings: cloudflare.TeamsAccountSettings{
 			Antivirus: antivirusConfig,
@@ -136,6 +170,18 @@ func resourceCloudflareTeamsAccountUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("error updating Teams Account configuration for account %q: %w", accountID, err))
 	}
 
+	// The custom certificate isn't supported by cloudflare-go yet so it's
+	// patched in separately. A removed certificate is disabled.
+	if customCertificateConfig != nil || d.HasChange("custom_certificate") {
+		if customCertificateConfig == nil {
+			customCertificateConfig = &teamsCustomCertificate{Enabled: false}
+		}
+		customCertificateUpdate := teamsConfiguration{Settings: teamsAccountSettings{CustomCertificate: customCertificateConfig}}
+		if err := rawAPIRequest(ctx, client, http.MethodPatch, teamsConfigurationURI(accountID), customCertificateUpdate, nil); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Teams Account custom certificate for account %q: %w", accountID, err))
+		}
+	}
+
 	if loggingConfig != nil {
 		if _, err := client.TeamsAccountUpdateLoggingConfiguration(ctx, accountID, *loggingConfig); err != nil {
 			return diag.FromErr(fmt.Errorf("error updating Teams Account logging settings for account %q: %w", accountID, err))
@@ -161,6 +207,36 @@ func resourceCloudflareTeamsAccountImport(ctx context.Context, d *schema.Resourc
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceCloudflareTeamsAccountValidateCustomCertificate checks the custom
+// certificate during plan whenever it changes.
+func resourceCloudflareTeamsAccountValidateCustomCertificate(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("account_id") || !d.NewValueKnown("custom_certificate") || !d.HasChange("custom_certificate") {
+		return nil
+	}
+
+	return validateTeamsCustomCertificate(ctx, meta.(*cloudflare.API), d.Get("account_id").(string), inflateTeamsCustomCertificate(d.Get("custom_certificate")))
+}
+
+// validateTeamsCustomCertificate ensures an enabled custom certificate
+// references a certificate that has been uploaded to the account, as Gateway
+// would otherwise fail to inspect TLS traffic.
+func validateTeamsCustomCertificate(ctx context.Context, client *cloudflare.API, accountID string, customCertificate *teamsCustomCertificate) error {
+	if customCertificate == nil || !customCertificate.Enabled {
+		return nil
+	}
+
+	if customCertificate.ID == "" {
+		return fmt.Errorf("custom_certificate.0.id must be set when the custom certificate is enabled")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mtls_certificates/%s", accountID, customCertificate.ID)
+	if err := rawAPIRequest(ctx, client, http.MethodGet, uri, nil, nil); err != nil {
+		return fmt.Errorf("error finding custom certificate %q for account %q: %w", customCertificate.ID, accountID, err)
+	}
+
+	return nil
+}
+
 func flattenBlockPageConfig(blockPage *cloudflare.TeamsBlockPage) []interface{} {
 	return []interface{}{map[string]interface{}{
 		"enabled":          *blockPage.Enabled,
@@ -260,6 +336,30 @@ func inflateFIPSConfig(fipsList interface{}) *cloudflare.TeamsFIPS {
 	}
 }
 
+func flattenTeamsCustomCertificate(customCertificate *teamsCustomCertificate) []interface{} {
+	if customCertificate == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"enabled": customCertificate.Enabled,
+		"id":      customCertificate.ID,
+	}}
+}
+
+func inflateTeamsCustomCertificate(customCertificate interface{}) *teamsCustomCertificate {
+	list := customCertificate.([]interface{})
+	if len(list) != 1 {
+		return nil
+	}
+
+	m := list[0].(map[string]interface{})
+	return &teamsCustomCertificate{
+		Enabled: m["enabled"].(bool),
+		ID:      m["id"].(string),
+	}
+}
+
 func inflateLoggingSettings(log interface{}) *cloudflare.TeamsLoggingSettings {
 	logList := log.([]interface{})
 
@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccCloudflareTeamsAccountConfigurationBasic(t *testing.T) {
@@ -56,6 +61,177 @@ func TestAccCloudflareTeamsAccountConfigurationBasic(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareTeamsAccountConfigurationCustomCertificate(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_account.%s", rnd)
+	certificateID := os.Getenv("CLOUDFLARE_GATEWAY_CUSTOM_CERTIFICATE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckGatewayCustomCertificate(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsAccountCustomCertificate(rnd, accountID, certificateID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "tls_decrypt_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "custom_certificate.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "custom_certificate.0.id", certificateID),
+				),
+			},
+			{
+				Config: testAccCloudflareTeamsAccountCustomCertificate(rnd, accountID, certificateID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "custom_certificate.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestTeamsAccountCustomCertificateMustExist(t *testing.T) {
+	var updates []teamsConfiguration
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/mtls_certificates/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1000, "message": "certificate not found"}], "messages": [], "result": null}`)
+			return
+		}
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "existing", "ca": true}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/gateway/configuration", func(w http.ResponseWriter, r *http.Request) {
+		var configuration teamsConfiguration
+		if r.Method == http.MethodPatch {
+			if !decodeTestRequestBody(t, w, r, &configuration) {
+				return
+			}
+			updates = append(updates, configuration)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"settings": {"activity_log": {"enabled": false}}}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/gateway/configuration/custom_certificate", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"enabled": true, "id": "existing"}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/gateway/logging", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"redact_pii": false}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/devices/settings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"gateway_proxy_enabled": false, "gateway_udp_proxy_enabled": false}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	testCases := map[string]struct {
+		certificate map[string]interface{}
+		wantErr     string
+	}{
+		"existing certificate": {
+			certificate: map[string]interface{}{"enabled": true, "id": "existing"},
+		},
+		"missing certificate": {
+			certificate: map[string]interface{}{"enabled": true, "id": "missing"},
+			wantErr:     `error finding custom certificate "missing"`,
+		},
+		"enabled without id": {
+			certificate: map[string]interface{}{"enabled": true},
+			wantErr:     "custom_certificate.0.id must be set",
+		},
+		"disabled without id": {
+			certificate: map[string]interface{}{"enabled": false},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			updates = nil
+
+			resource := resourceCloudflareTeamsAccount()
+			diff, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"account_id":         testAccCloudflareAccountID,
+				"custom_certificate": []interface{}{tc.certificate},
+			}), client)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q during plan, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error planning: %s", err)
+			}
+
+			_, diags := resource.Apply(context.Background(), nil, diff, client)
+			if diags.HasError() {
+				t.Fatalf("unexpected error applying: %v", diags)
+			}
+
+			if len(updates) != 1 || updates[0].Settings.CustomCertificate == nil {
+				t.Fatalf("expected the custom certificate to be sent once, got %+v", updates)
+			}
+			if got := *updates[0].Settings.CustomCertificate; got.Enabled != tc.certificate["enabled"] {
+				t.Errorf("expected the custom certificate to be enabled: %v, got %+v", tc.certificate["enabled"], got)
+			}
+		})
+	}
+}
+
+func TestTeamsAccountReadClearsRemovedCustomCertificate(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/gateway/configuration", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"settings": {"activity_log": {"enabled": false}}}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/gateway/configuration/custom_certificate", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/gateway/logging", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"redact_pii": false}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/devices/settings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"gateway_proxy_enabled": false, "gateway_udp_proxy_enabled": false}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareTeamsAccountSchema(), map[string]interface{}{
+		"account_id":         testAccCloudflareAccountID,
+		"custom_certificate": []interface{}{map[string]interface{}{"enabled": true, "id": "existing"}},
+	})
+	d.SetId(testAccCloudflareAccountID)
+
+	if diags := resourceCloudflareTeamsAccountRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("custom_certificate").([]interface{}); len(got) != 0 {
+		t.Errorf("expected the removed custom certificate to be cleared, got %v", got)
+	}
+}
+
 func testAccCloudflareTeamsAccountBasic(rnd, accountID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_teams_account" "%[1]s" {
@@ -102,3 +278,16 @@ resource "cloudflare_teams_account" "%[1]s" {
 }
 `, rnd, accountID)
 }
+
+func testAccCloudflareTeamsAccountCustomCertificate(rnd, accountID, certificateID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_account" "%[1]s" {
+  account_id = "%[2]s"
+  tls_decrypt_enabled = true
+  custom_certificate {
+    enabled = %[4]t
+    id = "%[3]s"
+  }
+}
+`, rnd, accountID, certificateID, enabled)
+}
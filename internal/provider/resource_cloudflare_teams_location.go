@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -24,6 +25,37 @@ func resourceCloudflareTeamsLocation() *schema.Resource {
 	}
 }
 
+// teamsLocation extends cloudflare.TeamsLocation with the DNS endpoints
+// cloudflare-go doesn't support yet. Locations only go through it, and a raw
+// API request, when endpoints are involved.
+type teamsLocation struct {
+	cloudflare.TeamsLocation
+	Endpoints *teamsLocationEndpoints `json:"endpoints,omitempty"`
+}
+
+type teamsLocationEndpoints struct {
+	DOH  *teamsLocationEndpoint `json:"doh,omitempty"`
+	DOT  *teamsLocationEndpoint `json:"dot,omitempty"`
+	IPv4 *teamsLocationEndpoint `json:"ipv4,omitempty"`
+	IPv6 *teamsLocationEndpoint `json:"ipv6,omitempty"`
+}
+
+type teamsLocationEndpoint struct {
+	Enabled bool `json:"enabled"`
+
+	// source networks allowed to use the endpoint, the IPv4 endpoint is
+	// restricted using the networks of the location instead.
+	Networks []teamsLocationEndpointNetwork `json:"networks,omitempty"`
+}
+
+type teamsLocationEndpointNetwork struct {
+	Network string `json:"network"`
+}
+
+func teamsLocationsURI(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/gateway/locations", accountID)
+}
+
 func resourceCloudflareTeamsLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
@@ -38,6 +70,13 @@ func resourceCloudflareTeamsLocationRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(fmt.Errorf("error finding Teams Location %q: %w", d.Id(), err))
 	}
 
+	// The DNS endpoints aren't exposed by cloudflare-go yet so they're read
+	// separately.
+	var endpoints teamsLocation
+	if err := rawAPIRequest(ctx, client, http.MethodGet, teamsLocationsURI(accountID)+"/"+d.Id(), nil, &endpoints); err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Teams Location %q endpoints: %w", d.Id(), err))
+	}
+
 	if err := d.Set("name", location.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing Location name"))
 	}
@@ -62,6 +101,15 @@ func resourceCloudflareTeamsLocationRead(ctx context.Context, d *schema.Resource
 	if err := d.Set("client_default", location.ClientDefault); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing Location client default"))
 	}
+	if err := d.Set("endpoints", flattenTeamsLocationEndpoints(endpoints.Endpoints)); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Location endpoints"))
+	}
+	if err := d.Set("doh_endpoint", teamsLocationDOHEndpoint(location.Subdomain)); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Location DOH endpoint"))
+	}
+	if err := d.Set("dot_endpoint", teamsLocationDOTEndpoint(location.Subdomain)); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Location DOT endpoint"))
+	}
 
 	return nil
 }
@@ -82,7 +130,14 @@ func resourceCloudflareTeamsLocationCreate(ctx context.Context, d *schema.Resour
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Teams Location from struct: %+v", newTeamLocation))
 
-	location, err := client.CreateTeamsLocation(ctx, accountID, newTeamLocation)
+	var location cloudflare.TeamsLocation
+	if endpoints := teamsLocationConfiguredEndpoints(d); endpoints != nil {
+		var created teamsLocation
+		err = rawAPIRequest(ctx, client, http.MethodPost, teamsLocationsURI(accountID), teamsLocation{TeamsLocation: newTeamLocation, Endpoints: endpoints}, &created)
+		location = created.TeamsLocation
+	} else {
+		location, err = client.CreateTeamsLocation(ctx, accountID, newTeamLocation)
+	}
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Teams Location for account %q: %w, %v", accountID, err, networks))
 	}
@@ -105,11 +160,18 @@ func resourceCloudflareTeamsLocationUpdate(ctx context.Context, d *schema.Resour
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams Location from struct: %+v", updatedTeamsLocation))
 
-	teamsLocation, err := client.UpdateTeamsLocation(ctx, accountID, updatedTeamsLocation)
+	var location cloudflare.TeamsLocation
+	if endpoints := teamsLocationConfiguredEndpoints(d); endpoints != nil {
+		var updated teamsLocation
+		err = rawAPIRequest(ctx, client, http.MethodPut, teamsLocationsURI(accountID)+"/"+d.Id(), teamsLocation{TeamsLocation: updatedTeamsLocation, Endpoints: endpoints}, &updated)
+		location = updated.TeamsLocation
+	} else {
+		location, err = client.UpdateTeamsLocation(ctx, accountID, updatedTeamsLocation)
+	}
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Teams Location for account %q: %w", accountID, err))
 	}
-	if teamsLocation.ID == "" {
+	if location.ID == "" {
 		return diag.FromErr(fmt.Errorf("failed to find Teams Location ID in update response; resource was empty"))
 	}
 	return resourceCloudflareTeamsLocationRead(ctx, d, meta)
@@ -180,3 +242,99 @@ func flattenTeamsLocationNetworks(networks []cloudflare.TeamsLocationNetwork) []
 	}
 	return flattenedNetworks
 }
+
+// teamsLocationConfiguredEndpoints returns the endpoints of the location when
+// they're set in the configuration. As they're computed, the ones in the state
+// are otherwise left for the API to manage.
+func teamsLocationConfiguredEndpoints(d *schema.ResourceData) *teamsLocationEndpoints {
+	if endpoints := getRawValue("endpoints.0", d.GetRawConfig()); endpoints.IsNull() {
+		return nil
+	}
+	return inflateTeamsLocationEndpoints(d.Get("endpoints"))
+}
+
+func inflateTeamsLocationEndpoints(endpoints interface{}) *teamsLocationEndpoints {
+	endpointsList, ok := endpoints.([]interface{})
+	if !ok || len(endpointsList) == 0 || endpointsList[0] == nil {
+		return nil
+	}
+
+	endpointsMap := endpointsList[0].(map[string]interface{})
+	return &teamsLocationEndpoints{
+		DOH:  inflateTeamsLocationEndpoint(endpointsMap["doh"]),
+		DOT:  inflateTeamsLocationEndpoint(endpointsMap["dot"]),
+		IPv4: inflateTeamsLocationEndpoint(endpointsMap["ipv4"]),
+		IPv6: inflateTeamsLocationEndpoint(endpointsMap["ipv6"]),
+	}
+}
+
+func inflateTeamsLocationEndpoint(endpoint interface{}) *teamsLocationEndpoint {
+	endpointList, ok := endpoint.([]interface{})
+	if !ok || len(endpointList) == 0 || endpointList[0] == nil {
+		return nil
+	}
+
+	endpointMap := endpointList[0].(map[string]interface{})
+	inflated := &teamsLocationEndpoint{
+		Enabled: endpointMap["enabled"].(bool),
+	}
+	if networks, ok := endpointMap["networks"].(*schema.Set); ok {
+		for _, network := range networks.List() {
+			inflated.Networks = append(inflated.Networks, teamsLocationEndpointNetwork{Network: network.(string)})
+		}
+	}
+
+	return inflated
+}
+
+func flattenTeamsLocationEndpoints(endpoints *teamsLocationEndpoints) []interface{} {
+	if endpoints == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"doh":  flattenTeamsLocationEndpoint(endpoints.DOH, true),
+		"dot":  flattenTeamsLocationEndpoint(endpoints.DOT, true),
+		"ipv4": flattenTeamsLocationEndpoint(endpoints.IPv4, false),
+		"ipv6": flattenTeamsLocationEndpoint(endpoints.IPv6, true),
+	}}
+}
+
+func flattenTeamsLocationEndpoint(endpoint *teamsLocationEndpoint, withNetworks bool) []interface{} {
+	if endpoint == nil {
+		return []interface{}{}
+	}
+
+	flattened := map[string]interface{}{
+		"enabled": endpoint.Enabled,
+	}
+	if withNetworks {
+		networks := make([]interface{}, 0, len(endpoint.Networks))
+		for _, network := range endpoint.Networks {
+			networks = append(networks, network.Network)
+		}
+		flattened["networks"] = networks
+	}
+
+	return []interface{}{flattened}
+}
+
+// teamsLocationDOHEndpoint returns the DNS over HTTPS URL of a location with
+// the given DoH subdomain.
+func teamsLocationDOHEndpoint(subdomain string) string {
+	if subdomain == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("https://%s.cloudflare-gateway.com/dns-query", subdomain)
+}
+
+// teamsLocationDOTEndpoint returns the DNS over TLS hostname of a location
+// with the given DoH subdomain.
+func teamsLocationDOTEndpoint(subdomain string) string {
+	if subdomain == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s.cloudflare-gateway.com", subdomain)
+}
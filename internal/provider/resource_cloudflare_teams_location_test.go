@@ -3,11 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -53,6 +57,169 @@ resource "cloudflare_teams_location" "%[1]s" {
 `, rnd, accountID)
 }
 
+func TestAccCloudflareTeamsLocationDOHEndpoint(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_location.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTeamsLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsLocationConfigDOHEndpoint(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "endpoints.0.doh.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "endpoints.0.doh.0.networks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "endpoints.0.doh.0.networks.*", "203.0.113.0/24"),
+					resource.TestCheckResourceAttr(name, "endpoints.0.dot.0.enabled", "false"),
+					resource.TestMatchResourceAttr(name, "doh_endpoint", regexp.MustCompile(`^https://[a-z0-9]+\.cloudflare-gateway\.com/dns-query$`)),
+					resource.TestMatchResourceAttr(name, "dot_endpoint", regexp.MustCompile(`^[a-z0-9]+\.cloudflare-gateway\.com$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareTeamsLocationInvalidEndpointNetwork(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      strings.Replace(testAccCloudflareTeamsLocationConfigDOHEndpoint(rnd, accountID), "203.0.113.0/24", "203.0.113.0", 1),
+				ExpectError: regexp.MustCompile(`to be a valid IPv4 Value, got 203.0.113.0`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareTeamsLocationConfigDOHEndpoint(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_location" "%[1]s" {
+  name       = "%[1]s"
+  account_id = "%[2]s"
+
+  endpoints {
+    doh {
+      enabled  = true
+      networks = ["203.0.113.0/24"]
+    }
+    dot {
+      enabled = false
+    }
+    ipv4 {
+      enabled = true
+    }
+    ipv6 {
+      enabled = false
+    }
+  }
+}
+`, rnd, accountID)
+}
+
+func TestTeamsLocationEndpointsRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareTeamsLocationSchema(), map[string]interface{}{
+		"account_id": testAccCloudflareAccountID,
+		"name":       "office",
+		"endpoints": []interface{}{map[string]interface{}{
+			"doh": []interface{}{map[string]interface{}{
+				"enabled":  true,
+				"networks": []interface{}{"203.0.113.0/24", "2001:db8::/32"},
+			}},
+			"ipv4": []interface{}{map[string]interface{}{
+				"enabled": true,
+			}},
+		}},
+	})
+
+	endpoints := inflateTeamsLocationEndpoints(d.Get("endpoints"))
+	if endpoints == nil || endpoints.DOH == nil || !endpoints.DOH.Enabled {
+		t.Fatalf("expected the DoH endpoint to be enabled, got %+v", endpoints)
+	}
+	if len(endpoints.DOH.Networks) != 2 {
+		t.Errorf("expected 2 DoH networks, got %+v", endpoints.DOH.Networks)
+	}
+	if endpoints.IPv4 == nil || !endpoints.IPv4.Enabled || len(endpoints.IPv4.Networks) != 0 {
+		t.Errorf("expected the IPv4 endpoint to be enabled without networks, got %+v", endpoints.IPv4)
+	}
+	if endpoints.DOT != nil || endpoints.IPv6 != nil {
+		t.Errorf("expected endpoints which aren't configured to be omitted, got %+v", endpoints)
+	}
+
+	if err := d.Set("endpoints", flattenTeamsLocationEndpoints(endpoints)); err != nil {
+		t.Fatalf("unexpected error setting endpoints: %s", err)
+	}
+	if got := d.Get("endpoints.0.doh.0.networks").(*schema.Set).Len(); got != 2 {
+		t.Errorf("expected 2 DoH networks after flattening, got %d", got)
+	}
+
+	if got := teamsLocationDOHEndpoint("abc123"); got != "https://abc123.cloudflare-gateway.com/dns-query" {
+		t.Errorf("unexpected DoH endpoint %q", got)
+	}
+	if got := teamsLocationDOTEndpoint(""); got != "" {
+		t.Errorf("expected no DoT endpoint without a subdomain, got %q", got)
+	}
+}
+
+func TestTeamsLocationCreateReadsEndpointsWhenNotConfigured(t *testing.T) {
+	var location map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/f037e56e89293a057740de681ac9abbe/gateway/locations", func(w http.ResponseWriter, r *http.Request) {
+		if !decodeTestRequestBody(t, w, r, &location) {
+			return
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "ed35569b41ce4d1facfe683550f54086"}}`)
+	})
+	mux.HandleFunc("/accounts/f037e56e89293a057740de681ac9abbe/gateway/locations/ed35569b41ce4d1facfe683550f54086", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {
+			"id": "ed35569b41ce4d1facfe683550f54086",
+			"name": "office",
+			"doh_subdomain": "oli3n9zkz5",
+			"endpoints": {"doh": {"enabled": true}, "dot": {"enabled": false}, "ipv4": {"enabled": false}, "ipv6": {"enabled": false}}
+		}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareTeamsLocationSchema(), map[string]interface{}{
+		"account_id": "f037e56e89293a057740de681ac9abbe",
+		"name":       "office",
+	})
+	if diags := resourceCloudflareTeamsLocationCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error creating location: %v", diags)
+	}
+
+	if _, ok := location["endpoints"]; ok {
+		t.Errorf("expected no endpoints to be sent, got %v", location)
+	}
+
+	if !d.Get("endpoints.0.doh.0.enabled").(bool) {
+		t.Error("expected the DoH endpoint to be read back as enabled")
+	}
+	if d.Get("endpoints.0.dot.0.enabled").(bool) {
+		t.Error("expected the DoT endpoint to be read back as disabled")
+	}
+}
+
 func testAccCheckCloudflareTeamsLocationDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
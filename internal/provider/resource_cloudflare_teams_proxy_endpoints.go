@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -21,6 +23,9 @@ func resourceCloudflareTeamsProxyEndpoint() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareTeamsProxyEndpointImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
 	}
 }
 
@@ -70,9 +75,35 @@ func resourceCloudflareTeamsProxyEndpointCreate(ctx context.Context, d *schema.R
 	}
 
 	d.SetId(proxyEndpoint.ID)
+
+	if d.Get("wait_for_subdomain").(bool) && proxyEndpoint.Subdomain == "" {
+		if err := waitForTeamsProxyEndpointSubdomain(ctx, client, accountID, proxyEndpoint.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCloudflareTeamsProxyEndpointRead(ctx, d, meta)
 }
 
+// waitForTeamsProxyEndpointSubdomain polls the proxy endpoint until the API
+// has provisioned and assigned a subdomain to it or the timeout elapses.
+func waitForTeamsProxyEndpointSubdomain(ctx context.Context, client *cloudflare.API, accountID, proxyEndpointID string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		endpoint, err := client.TeamsProxyEndpoint(ctx, accountID, proxyEndpointID)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error finding Teams Proxy Endpoint %q: %w", proxyEndpointID, err))
+		}
+
+		if endpoint.Subdomain == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Teams Proxy Endpoint %s has no subdomain assigned yet, waiting", proxyEndpointID))
+			return resource.RetryableError(fmt.Errorf("waiting for Teams Proxy Endpoint %q subdomain to be assigned", proxyEndpointID))
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Teams Proxy Endpoint %s subdomain assigned: %s", proxyEndpointID, endpoint.Subdomain))
+		return nil
+	})
+}
+
 func resourceCloudflareTeamsProxyEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
@@ -123,6 +154,7 @@ func resourceCloudflareTeamsProxyEndpointImport(ctx context.Context, d *schema.R
 	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Teams Proxy Endpoint: id %s for account %s", teamsProxyEndpointID, accountID))
 
 	d.Set("account_id", accountID)
+	d.Set("wait_for_subdomain", false)
 	d.SetId(teamsProxyEndpointID)
 
 	resourceCloudflareTeamsProxyEndpointRead(ctx, d, meta)
@@ -3,12 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -72,3 +74,44 @@ func testAccCheckCloudflareTeamsProxyEndpointDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestTeamsProxyEndpointCreateWaitsForSubdomain(t *testing.T) {
+	var reads int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/gateway/proxy_endpoints", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "ed35569b41ce4d1facfe683550f54086", "name": "office", "ips": ["192.0.2.0/24"], "subdomain": ""}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/gateway/proxy_endpoints/ed35569b41ce4d1facfe683550f54086", func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		subdomain := ""
+		if reads > 2 {
+			subdomain = "oli3n9zkz5"
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "ed35569b41ce4d1facfe683550f54086", "name": "office", "ips": ["192.0.2.0/24"], "subdomain": %q}}`, subdomain)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareTeamsProxyEndpointSchema(), map[string]interface{}{
+		"account_id":         testAccCloudflareAccountID,
+		"name":               "office",
+		"ips":                []interface{}{"192.0.2.0/24"},
+		"wait_for_subdomain": true,
+	})
+
+	if diags := resourceCloudflareTeamsProxyEndpointCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error creating proxy endpoint: %v", diags)
+	}
+
+	if got := d.Get("subdomain").(string); got != "oli3n9zkz5" {
+		t.Fatalf("expected subdomain %q, got %q", "oli3n9zkz5", got)
+	}
+
+	if reads < 3 {
+		t.Fatalf("expected the proxy endpoint to be polled until a subdomain was assigned, got %d reads", reads)
+	}
+}
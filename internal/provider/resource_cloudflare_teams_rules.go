@@ -23,11 +23,84 @@ func resourceCloudflareTeamsRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareTeamsRuleImport,
 		},
+		CustomizeDiff: resourceCloudflareTeamsRuleValidateResolverSettings,
 	}
 }
 
 const rulePrecedenceFactor int64 = 1000
 
+// teamsRule extends cloudflare.TeamsRule with the rule settings
+// cloudflare-go doesn't support yet. Rules only go through it, and a raw API
+// request, when they use one of those settings.
+type teamsRule struct {
+	cloudflare.TeamsRule
+	RuleSettings teamsRuleExtendedSettings `json:"rule_settings"`
+}
+
+type teamsRuleExtendedSettings struct {
+	cloudflare.TeamsRuleSettings
+
+	// resolve DNS queries matching a resolve rule using Cloudflare's
+	// resolvers rather than the ones in DNSResolvers
+	ResolveDNSThroughCloudflare bool `json:"resolve_dns_through_cloudflare,omitempty"`
+
+	// custom resolvers to send DNS queries matching a resolve rule to
+	DNSResolvers *teamsDNSResolverSettings `json:"dns_resolvers,omitempty"`
+
+	// notification shown to the user when the rule matches
+	NotificationSettings *teamsRuleNotificationSettings `json:"notification_settings,omitempty"`
+
+	// settings for quarantining files matching the rule
+	Quarantine *teamsRuleQuarantineSettings `json:"quarantine,omitempty"`
+}
+
+type teamsRuleNotificationSettings struct {
+	Enabled    bool   `json:"enabled"`
+	Message    string `json:"msg"`
+	SupportURL string `json:"support_url"`
+}
+
+type teamsRuleQuarantineSettings struct {
+	FileTypes []string `json:"file_types"`
+}
+
+type teamsDNSResolverSettings struct {
+	IPv4 []teamsDNSResolverAddressSettings `json:"ipv4,omitempty"`
+	IPv6 []teamsDNSResolverAddressSettings `json:"ipv6,omitempty"`
+}
+
+type teamsDNSResolverAddressSettings struct {
+	IP                         string `json:"ip"`
+	Port                       int    `json:"port,omitempty"`
+	VnetID                     string `json:"vnet_id,omitempty"`
+	RouteThroughPrivateNetwork bool   `json:"route_through_private_network,omitempty"`
+}
+
+// teamsRuleSettingsUseExtendedFields reports whether the settings use any of
+// the fields cloudflare-go doesn't support yet.
+func teamsRuleSettingsUseExtendedFields(settings *teamsRuleExtendedSettings) bool {
+	return settings != nil && (settings.ResolveDNSThroughCloudflare ||
+		settings.DNSResolvers != nil ||
+		settings.NotificationSettings != nil ||
+		settings.Quarantine != nil)
+}
+
+// teamsRuleActionMayUseExtendedSettings reports whether rules with the given
+// action can have settings cloudflare-go doesn't support yet: resolvers for
+// resolve rules, quarantined file types and the notification shown when
+// traffic is blocked.
+func teamsRuleActionMayUseExtendedSettings(action string) bool {
+	switch action {
+	case teamsRuleActionResolve, teamsRuleActionQuarantine, string(cloudflare.Block):
+		return true
+	}
+	return false
+}
+
+func teamsRulesURI(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/gateway/rules", accountID)
+}
+
 func resourceCloudflareTeamsRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get("account_id").(string)
@@ -41,6 +114,15 @@ func resourceCloudflareTeamsRuleRead(ctx context.Context, d *schema.ResourceData
 		}
 		return diag.FromErr(fmt.Errorf("error finding Teams Rule %q: %w", d.Id(), err))
 	}
+
+	settings := teamsRuleExtendedSettings{TeamsRuleSettings: rule.RuleSettings}
+	if teamsRuleActionMayUseExtendedSettings(string(rule.Action)) {
+		var extendedRule teamsRule
+		if err := rawAPIRequest(ctx, client, http.MethodGet, teamsRulesURI(accountID)+"/"+d.Id(), nil, &extendedRule); err != nil {
+			return diag.FromErr(fmt.Errorf("error finding Teams Rule %q: %w", d.Id(), err))
+		}
+		settings = extendedRule.RuleSettings
+	}
 	if err := d.Set("name", rule.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing rule name"))
 	}
@@ -71,7 +153,7 @@ func resourceCloudflareTeamsRuleRead(ctx context.Context, d *schema.ResourceData
 	if err := d.Set("version", int64(rule.Version)); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing rule version"))
 	}
-	if err := d.Set("rule_settings", flattenTeamsRuleSettings(&rule.RuleSettings)); err != nil {
+	if err := d.Set("rule_settings", flattenTeamsRuleSettings(&settings)); err != nil {
 		return diag.FromErr(fmt.Errorf("error parsing rule settings"))
 	}
 	return nil
@@ -104,12 +186,20 @@ func resourceCloudflareTeamsRuleCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if settings != nil {
-		newTeamsRule.RuleSettings = *settings
+		newTeamsRule.RuleSettings = settings.TeamsRuleSettings
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Teams Rule from struct: %+v", newTeamsRule))
 
-	rule, err := client.TeamsCreateRule(ctx, accountID, newTeamsRule)
+	var rule cloudflare.TeamsRule
+	var err error
+	if teamsRuleSettingsUseExtendedFields(settings) {
+		var extendedRule teamsRule
+		err = rawAPIRequest(ctx, client, http.MethodPost, teamsRulesURI(accountID), teamsRule{TeamsRule: newTeamsRule, RuleSettings: *settings}, &extendedRule)
+		rule = extendedRule.TeamsRule
+	} else {
+		rule, err = client.TeamsCreateRule(ctx, accountID, newTeamsRule)
+	}
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Teams rule for account %q: %w", accountID, err))
 	}
@@ -130,7 +220,7 @@ func resourceCloudflareTeamsRuleUpdate(ctx context.Context, d *schema.ResourceDa
 
 	ruleName := d.Get("name").(string)
 	apiPrecedence := providerToApiRulePrecedence(int64(d.Get("precedence").(int)), ruleName)
-	teamsRule := cloudflare.TeamsRule{
+	updatedRule := cloudflare.TeamsRule{
 		ID:            d.Id(),
 		Name:          ruleName,
 		Description:   d.Get("description").(string),
@@ -145,11 +235,19 @@ func resourceCloudflareTeamsRuleUpdate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if settings != nil {
-		teamsRule.RuleSettings = *settings
+		updatedRule.RuleSettings = settings.TeamsRuleSettings
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams rule from struct: %+v", teamsRule))
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams rule from struct: %+v", updatedRule))
 
-	updatedTeamsRule, err := client.TeamsUpdateRule(ctx, accountID, teamsRule.ID, teamsRule)
+	var updatedTeamsRule cloudflare.TeamsRule
+	var err error
+	if teamsRuleSettingsUseExtendedFields(settings) {
+		var extendedRule teamsRule
+		err = rawAPIRequest(ctx, client, http.MethodPut, teamsRulesURI(accountID)+"/"+d.Id(), teamsRule{TeamsRule: updatedRule, RuleSettings: *settings}, &extendedRule)
+		updatedTeamsRule = extendedRule.TeamsRule
+	} else {
+		updatedTeamsRule, err = client.TeamsUpdateRule(ctx, accountID, updatedRule.ID, updatedRule)
+	}
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Teams rule for account %q: %w", accountID, err))
 	}
@@ -193,7 +291,7 @@ func resourceCloudflareTeamsRuleImport(ctx context.Context, d *schema.ResourceDa
 	return []*schema.ResourceData{d}, nil
 }
 
-func flattenTeamsRuleSettings(settings *cloudflare.TeamsRuleSettings) []interface{} {
+func flattenTeamsRuleSettings(settings *teamsRuleExtendedSettings) []interface{} {
 	return []interface{}{map[string]interface{}{
 		"block_page_enabled":                 settings.BlockPageEnabled,
 		"block_page_reason":                  settings.BlockReason,
@@ -204,10 +302,14 @@ func flattenTeamsRuleSettings(settings *cloudflare.TeamsRuleSettings) []interfac
 		"check_session":                      flattenTeamsCheckSessionSettings(settings.CheckSession),
 		"add_headers":                        flattenTeamsAddHeaders(settings.AddHeaders),
 		"insecure_disable_dnssec_validation": settings.InsecureDisableDNSSECValidation,
+		"resolve_dns_through_cloudflare":     settings.ResolveDNSThroughCloudflare,
+		"dns_resolvers":                      flattenTeamsDNSResolvers(settings.DNSResolvers),
+		"notification_settings":              flattenTeamsNotificationSettings(settings.NotificationSettings),
+		"quarantine":                         flattenTeamsQuarantineSettings(settings.Quarantine),
 	}}
 }
 
-func inflateTeamsRuleSettings(settings interface{}) *cloudflare.TeamsRuleSettings {
+func inflateTeamsRuleSettings(settings interface{}) *teamsRuleExtendedSettings {
 	settingsList := settings.([]interface{})
 	if len(settingsList) != 1 {
 		return nil
@@ -229,17 +331,27 @@ func inflateTeamsRuleSettings(settings interface{}) *cloudflare.TeamsRuleSetting
 	checkSessionSettings := inflateTeamsCheckSessionSettings(settingsMap["check_session"].([]interface{}))
 	addHeaders := inflateTeamsAddHeaders(settingsMap["add_headers"].(map[string]interface{}))
 	insecureDisableDNSSECValidation := settingsMap["insecure_disable_dnssec_validation"].(bool)
-
-	return &cloudflare.TeamsRuleSettings{
-		BlockPageEnabled:                enabled,
-		BlockReason:                     reason,
-		OverrideIPs:                     overrideIPs,
-		OverrideHost:                    overrideHost,
-		L4Override:                      l4Override,
-		BISOAdminControls:               bisoAdminControls,
-		CheckSession:                    checkSessionSettings,
-		AddHeaders:                      addHeaders,
-		InsecureDisableDNSSECValidation: insecureDisableDNSSECValidation,
+	resolveDNSThroughCloudflare := settingsMap["resolve_dns_through_cloudflare"].(bool)
+	dnsResolvers := inflateTeamsDNSResolvers(settingsMap["dns_resolvers"].([]interface{}))
+	notificationSettings := inflateTeamsNotificationSettings(settingsMap["notification_settings"].([]interface{}))
+	quarantine := inflateTeamsQuarantineSettings(settingsMap["quarantine"].([]interface{}))
+
+	return &teamsRuleExtendedSettings{
+		TeamsRuleSettings: cloudflare.TeamsRuleSettings{
+			BlockPageEnabled:                enabled,
+			BlockReason:                     reason,
+			OverrideIPs:                     overrideIPs,
+			OverrideHost:                    overrideHost,
+			L4Override:                      l4Override,
+			BISOAdminControls:               bisoAdminControls,
+			CheckSession:                    checkSessionSettings,
+			AddHeaders:                      addHeaders,
+			InsecureDisableDNSSECValidation: insecureDisableDNSSECValidation,
+		},
+		ResolveDNSThroughCloudflare: resolveDNSThroughCloudflare,
+		DNSResolvers:                dnsResolvers,
+		NotificationSettings:        notificationSettings,
+		Quarantine:                  quarantine,
 	}
 }
 
@@ -362,6 +474,128 @@ func inflateTeamsL4Override(settings interface{}) *cloudflare.TeamsL4OverrideSet
 	}
 }
 
+func flattenTeamsDNSResolvers(settings *teamsDNSResolverSettings) []interface{} {
+	if settings == nil || (len(settings.IPv4) == 0 && len(settings.IPv6) == 0) {
+		return nil
+	}
+
+	flattenAddresses := func(addresses []teamsDNSResolverAddressSettings) []interface{} {
+		flattened := make([]interface{}, 0, len(addresses))
+		for _, address := range addresses {
+			flattened = append(flattened, map[string]interface{}{
+				"ip":                            address.IP,
+				"port":                          address.Port,
+				"vnet_id":                       address.VnetID,
+				"route_through_private_network": address.RouteThroughPrivateNetwork,
+			})
+		}
+		return flattened
+	}
+
+	return []interface{}{map[string]interface{}{
+		"ipv4": flattenAddresses(settings.IPv4),
+		"ipv6": flattenAddresses(settings.IPv6),
+	}}
+}
+
+func inflateTeamsDNSResolvers(settings []interface{}) *teamsDNSResolverSettings {
+	if len(settings) != 1 || settings[0] == nil {
+		return nil
+	}
+	settingsMap := settings[0].(map[string]interface{})
+
+	inflateAddresses := func(addresses []interface{}) []teamsDNSResolverAddressSettings {
+		var inflated []teamsDNSResolverAddressSettings
+		for _, address := range addresses {
+			addressMap := address.(map[string]interface{})
+			inflated = append(inflated, teamsDNSResolverAddressSettings{
+				IP:                         addressMap["ip"].(string),
+				Port:                       addressMap["port"].(int),
+				VnetID:                     addressMap["vnet_id"].(string),
+				RouteThroughPrivateNetwork: addressMap["route_through_private_network"].(bool),
+			})
+		}
+		return inflated
+	}
+
+	return &teamsDNSResolverSettings{
+		IPv4: inflateAddresses(settingsMap["ipv4"].([]interface{})),
+		IPv6: inflateAddresses(settingsMap["ipv6"].([]interface{})),
+	}
+}
+
+func flattenTeamsNotificationSettings(settings *teamsRuleNotificationSettings) []interface{} {
+	if settings == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"enabled":     settings.Enabled,
+		"msg":         settings.Message,
+		"support_url": settings.SupportURL,
+	}}
+}
+
+func inflateTeamsNotificationSettings(settings []interface{}) *teamsRuleNotificationSettings {
+	if len(settings) != 1 || settings[0] == nil {
+		return nil
+	}
+	settingsMap := settings[0].(map[string]interface{})
+	return &teamsRuleNotificationSettings{
+		Enabled:    settingsMap["enabled"].(bool),
+		Message:    settingsMap["msg"].(string),
+		SupportURL: settingsMap["support_url"].(string),
+	}
+}
+
+func flattenTeamsQuarantineSettings(settings *teamsRuleQuarantineSettings) []interface{} {
+	if settings == nil || len(settings.FileTypes) == 0 {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"file_types": schema.NewSet(schema.HashString, flattenStringList(settings.FileTypes)),
+	}}
+}
+
+func inflateTeamsQuarantineSettings(settings []interface{}) *teamsRuleQuarantineSettings {
+	if len(settings) != 1 || settings[0] == nil {
+		return nil
+	}
+	settingsMap := settings[0].(map[string]interface{})
+	return &teamsRuleQuarantineSettings{
+		FileTypes: expandInterfaceToStringList(settingsMap["file_types"].(*schema.Set).List()),
+	}
+}
+
+// resourceCloudflareTeamsRuleValidateResolverSettings ensures the DNS resolver
+// settings are only used by, and always set for, rules using the resolve
+// action.
+func resourceCloudflareTeamsRuleValidateResolverSettings(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("action") || !d.NewValueKnown("rule_settings") {
+		return nil
+	}
+
+	action := d.Get("action").(string)
+	_, hasResolvers := d.GetOk("rule_settings.0.dns_resolvers")
+	resolveThroughCloudflare := d.Get("rule_settings.0.resolve_dns_through_cloudflare").(bool)
+
+	return validateTeamsRuleResolverSettings(action, hasResolvers, resolveThroughCloudflare)
+}
+
+func validateTeamsRuleResolverSettings(action string, hasResolvers, resolveThroughCloudflare bool) error {
+	if action == teamsRuleActionResolve {
+		if !hasResolvers && !resolveThroughCloudflare {
+			return fmt.Errorf("rules using the %q action must set either `rule_settings.dns_resolvers` or `rule_settings.resolve_dns_through_cloudflare`", teamsRuleActionResolve)
+		}
+		return nil
+	}
+
+	if hasResolvers || resolveThroughCloudflare {
+		return fmt.Errorf("`rule_settings.dns_resolvers` and `rule_settings.resolve_dns_through_cloudflare` can only be used with the %q action, got %q", teamsRuleActionResolve, action)
+	}
+
+	return nil
+}
+
 func providerToApiRulePrecedence(provided int64, ruleName string) int64 {
 	return provided*rulePrecedenceFactor + int64(hashCodeString(ruleName))%rulePrecedenceFactor
 }
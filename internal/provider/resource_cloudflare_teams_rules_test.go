@@ -3,7 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -52,6 +55,264 @@ func TestAccCloudflareTeamsRuleBasic(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareTeamsRuleCustomResolver(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_rule.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccessAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTeamsRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsRuleConfigCustomResolver(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "action", "resolve"),
+					resource.TestCheckResourceAttr(name, "filters.0", "dns_resolver"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.resolve_dns_through_cloudflare", "false"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv4.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv4.0.ip", "192.0.2.53"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv4.0.port", "5053"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv4.0.route_through_private_network", "false"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv6.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv6.0.ip", "2001:db8::53"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.dns_resolvers.0.ipv6.0.port", "53"),
+				),
+			},
+			{
+				Config:   testAccCloudflareTeamsRuleConfigCustomResolver(rnd, accountID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareTeamsRuleBlockNotification(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_rule.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccessAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTeamsRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsRuleConfigBlockNotification(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "action", "block"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.notification_settings.#", "1"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.notification_settings.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.notification_settings.0.msg", "This site is blocked by your organisation"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.notification_settings.0.support_url", "https://support.example.com/blocked"),
+				),
+			},
+			{
+				Config:   testAccCloudflareTeamsRuleConfigBlockNotification(rnd, accountID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareTeamsRuleInvalidQuarantineFileType(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccessAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareTeamsRuleConfigQuarantine(rnd, accountID, "jpg"),
+				ExpectError: regexp.MustCompile(`expected rule_settings.0.quarantine.0.file_types.\d+ to be one of`),
+			},
+		},
+	})
+}
+
+func TestTeamsRuleNotificationAndQuarantineRoundTrip(t *testing.T) {
+	notification := &teamsRuleNotificationSettings{
+		Enabled:    true,
+		Message:    "This site is blocked by your organisation",
+		SupportURL: "https://support.example.com/blocked",
+	}
+	if got := inflateTeamsNotificationSettings(flattenTeamsNotificationSettings(notification)); !reflect.DeepEqual(got, notification) {
+		t.Errorf("expected %#v, got %#v", notification, got)
+	}
+
+	quarantine := &teamsRuleQuarantineSettings{FileTypes: []string{"exe"}}
+	if got := inflateTeamsQuarantineSettings(flattenTeamsQuarantineSettings(quarantine)); !reflect.DeepEqual(got, quarantine) {
+		t.Errorf("expected %#v, got %#v", quarantine, got)
+	}
+
+	if got := flattenTeamsQuarantineSettings(&teamsRuleQuarantineSettings{}); got != nil {
+		t.Errorf("expected no quarantined file types to be flattened to nil, got %#v", got)
+	}
+}
+
+func TestAccCloudflareTeamsRuleInvalidResolverIP(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccessAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareTeamsRuleConfigResolverIP(rnd, accountID, "2001:db8::53"),
+				ExpectError: regexp.MustCompile(`expected rule_settings.0.dns_resolvers.0.ipv4.0.ip to contain a valid IPv4 address`),
+			},
+		},
+	})
+}
+
+func TestValidateTeamsRuleResolverSettings(t *testing.T) {
+	testCases := map[string]struct {
+		action                   string
+		hasResolvers             bool
+		resolveThroughCloudflare bool
+		err                      string
+	}{
+		"resolve action with custom resolvers": {
+			action:       "resolve",
+			hasResolvers: true,
+		},
+		"resolve action through Cloudflare": {
+			action:                   "resolve",
+			resolveThroughCloudflare: true,
+		},
+		"resolve action without a resolver": {
+			action: "resolve",
+			err:    "rules using the \"resolve\" action must set either `rule_settings.dns_resolvers` or `rule_settings.resolve_dns_through_cloudflare`",
+		},
+		"block action without resolvers": {
+			action: "block",
+		},
+		"block action with custom resolvers": {
+			action:       "block",
+			hasResolvers: true,
+			err:          "`rule_settings.dns_resolvers` and `rule_settings.resolve_dns_through_cloudflare` can only be used with the \"resolve\" action, got \"block\"",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateTeamsRuleResolverSettings(tc.action, tc.hasResolvers, tc.resolveThroughCloudflare)
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestTeamsDNSResolversRoundTrip(t *testing.T) {
+	resolvers := &teamsDNSResolverSettings{
+		IPv4: []teamsDNSResolverAddressSettings{
+			{IP: "192.0.2.53", Port: 5053},
+			{IP: "10.0.0.53", Port: 53, VnetID: "1e9e2a87-9a2b-4e0a-8a17-5b9a0e6d0f1c", RouteThroughPrivateNetwork: true},
+		},
+		IPv6: []teamsDNSResolverAddressSettings{
+			{IP: "2001:db8::53", Port: 53},
+		},
+	}
+
+	if got := inflateTeamsDNSResolvers(flattenTeamsDNSResolvers(resolvers)); !reflect.DeepEqual(got, resolvers) {
+		t.Errorf("expected %#v, got %#v", resolvers, got)
+	}
+
+	if got := flattenTeamsDNSResolvers(nil); got != nil {
+		t.Errorf("expected no resolvers to be flattened to nil, got %#v", got)
+	}
+}
+
+func TestTeamsRuleReadOnlyRequestsExtendedSettingsWhenUsed(t *testing.T) {
+	testCases := map[string]struct {
+		action           string
+		expectedRequests int
+	}{
+		"allow rule": {
+			action:           "allow",
+			expectedRequests: 1,
+		},
+		"resolve rule": {
+			action:           "resolve",
+			expectedRequests: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("/accounts/f037e56e89293a057740de681ac9abbe/gateway/rules/3e4b9b5a-4e8b-4c2b-9d1a-7a5f0e0c2d1b", func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("content-type", "application/json")
+				fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {
+					"id": "3e4b9b5a-4e8b-4c2b-9d1a-7a5f0e0c2d1b",
+					"name": "example",
+					"precedence": 1000,
+					"enabled": true,
+					"action": %q,
+					"filters": ["dns_resolver"],
+					"traffic": "any(dns.domains[*] == \"internal.example.com\")",
+					"rule_settings": {"resolve_dns_through_cloudflare": true}
+				}}`, tc.action)
+			})
+
+			client := newTestAPIClient(t, mux)
+
+			d := resourceCloudflareTeamsRule().TestResourceData()
+			d.Set("account_id", "f037e56e89293a057740de681ac9abbe")
+			d.SetId("3e4b9b5a-4e8b-4c2b-9d1a-7a5f0e0c2d1b")
+
+			if diags := resourceCloudflareTeamsRuleRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error reading rule: %v", diags)
+			}
+
+			if requests != tc.expectedRequests {
+				t.Errorf("expected %d requests, got %d", tc.expectedRequests, requests)
+			}
+		})
+	}
+}
+
 func testAccCloudflareTeamsRuleConfigBasic(rnd, accountID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_teams_rule" "%[1]s" {
@@ -71,6 +332,93 @@ resource "cloudflare_teams_rule" "%[1]s" {
 `, rnd, accountID)
 }
 
+func testAccCloudflareTeamsRuleConfigCustomResolver(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_rule" "%[1]s" {
+  name = "%[1]s"
+  account_id = "%[2]s"
+  description = "desc"
+  precedence = 12303
+  action = "resolve"
+  filters = ["dns_resolver"]
+  traffic = "any(dns.domains[*] == \"internal.example.com\")"
+  rule_settings {
+    dns_resolvers {
+      ipv4 {
+        ip = "192.0.2.53"
+        port = 5053
+      }
+      ipv6 {
+        ip = "2001:db8::53"
+      }
+    }
+  }
+}
+`, rnd, accountID)
+}
+
+func testAccCloudflareTeamsRuleConfigResolverIP(rnd, accountID, ip string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_rule" "%[1]s" {
+  name = "%[1]s"
+  account_id = "%[2]s"
+  description = "desc"
+  precedence = 12304
+  action = "resolve"
+  filters = ["dns_resolver"]
+  traffic = "any(dns.domains[*] == \"internal.example.com\")"
+  rule_settings {
+    dns_resolvers {
+      ipv4 {
+        ip = "%[3]s"
+      }
+    }
+  }
+}
+`, rnd, accountID, ip)
+}
+
+func testAccCloudflareTeamsRuleConfigBlockNotification(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_rule" "%[1]s" {
+  name = "%[1]s"
+  account_id = "%[2]s"
+  description = "desc"
+  precedence = 12305
+  action = "block"
+  filters = ["dns"]
+  traffic = "any(dns.domains[*] == \"example.com\")"
+  rule_settings {
+    block_page_enabled = false
+    notification_settings {
+      enabled = true
+      msg = "This site is blocked by your organisation"
+      support_url = "https://support.example.com/blocked"
+    }
+  }
+}
+`, rnd, accountID)
+}
+
+func testAccCloudflareTeamsRuleConfigQuarantine(rnd, accountID, fileType string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_rule" "%[1]s" {
+  name = "%[1]s"
+  account_id = "%[2]s"
+  description = "desc"
+  precedence = 12306
+  action = "quarantine"
+  filters = ["http"]
+  traffic = "http.request.uri.path contains \"/downloads\""
+  rule_settings {
+    quarantine {
+      file_types = ["%[3]s"]
+    }
+  }
+}
+`, rnd, accountID, fileType)
+}
+
 func testAccCheckCloudflareTeamsRuleDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
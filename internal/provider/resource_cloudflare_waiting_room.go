@@ -22,7 +22,8 @@ func resourceCloudflareWaitingRoom() *schema.Resource {
 			StateContext: resourceCloudflareWaitingRoomImport,
 		},
 
-		Schema: resourceCloudflareWaitingRoomSchema(),
+		Schema:        resourceCloudflareWaitingRoomSchema(),
+		CustomizeDiff: resourceCloudflareWaitingRoomValidateHost,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
 			Update: schema.DefaultTimeout(30 * time.Second),
@@ -31,6 +32,23 @@ func resourceCloudflareWaitingRoom() *schema.Resource {
 	}
 }
 
+// resourceCloudflareWaitingRoomValidateHost ensures the `host` of the waiting
+// room belongs to its zone as the API rejects hosts of other zones.
+func resourceCloudflareWaitingRoomValidateHost(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("zone_id") || !d.NewValueKnown("host") || d.Id() != "" && !d.HasChange("host") {
+		return nil
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	client := meta.(*cloudflare.API)
+	zone, err := client.ZoneDetails(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("error finding zone %q: %w", zoneID, err)
+	}
+
+	return validateZoneHostnames(zone.Name, []string{d.Get("host").(string)})
+}
+
 func buildWaitingRoom(d *schema.ResourceData) cloudflare.WaitingRoom {
 	return cloudflare.WaitingRoom{
 		Name:                    d.Get("name").(string),
@@ -3,7 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -45,6 +48,28 @@ func TestAccCloudflareWaitingRoom_Create(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWaitingRoom_InvalidHostAndPath(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	waitingRoomName := fmt.Sprintf("waiting_room_%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareWaitingRoom(rnd, waitingRoomName, zoneID, domain, "foobar"),
+				ExpectError: regexp.MustCompile("must begin with `/`"),
+			},
+			{
+				Config:      testAccCloudflareWaitingRoom(rnd, waitingRoomName, zoneID, "example.net", "/foobar"),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`hostname "www.example.net" doesn't belong to zone "%s"`, regexp.QuoteMeta(domain))),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudflareWaitingRoomDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
@@ -82,3 +107,56 @@ resource "cloudflare_waiting_room" "%[1]s" {
 }
 `, resourceName, waitingRoomName, zoneID, domain, path)
 }
+
+func TestWaitingRoomHostAndPathValidation(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "example.com"}}`, testAccCloudflareZoneID)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	testCases := map[string]struct {
+		host, path string
+		err        string
+	}{
+		"zone apex":           {host: "example.com", path: "/"},
+		"subdomain":           {host: "Shop.Example.com", path: "/checkout"},
+		"host outside zone":   {host: "www.example.net", path: "/", err: `hostname "www.example.net" doesn't belong to zone "example.com"`},
+		"host with zone name": {host: "notexample.com", path: "/", err: `hostname "notexample.com" doesn't belong to zone "example.com"`},
+		"wildcard host":       {host: "*.example.com", path: "/", err: "must be a host name without wildcards, scheme, port or path"},
+		"host with scheme":    {host: "https://example.com", path: "/", err: "must be a host name without wildcards, scheme, port or path"},
+		"relative path":       {host: "example.com", path: "checkout", err: "must begin with `/`"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"zone_id":              testAccCloudflareZoneID,
+				"name":                 "waiting_room",
+				"host":                 tc.host,
+				"path":                 tc.path,
+				"new_users_per_minute": 200,
+				"total_active_users":   200,
+			})
+
+			var err error
+			if diags := resourceCloudflareWaitingRoom().Validate(config); diags.HasError() {
+				err = fmt.Errorf("%s", diags[0].Summary)
+			} else {
+				_, err = resourceCloudflareWaitingRoom().Diff(context.Background(), nil, config, client)
+			}
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
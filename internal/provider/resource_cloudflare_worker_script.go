@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
@@ -24,9 +25,43 @@ func resourceCloudflareWorkerScript() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareWorkerScriptImport,
 		},
+		CustomizeDiff: resourceCloudflareWorkerScriptContentHash,
 	}
 }
 
+// normalizeWorkerScriptContent strips the line ending differences which are
+// introduced between the local file and the API: CRLF line endings and the
+// newline at the end of the file. Any other whitespace is kept as it can be
+// significant, e.g. within template literals.
+func normalizeWorkerScriptContent(content string) string {
+	return strings.TrimSuffix(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+}
+
+// workerScriptContentHash returns the hash of the normalized script content.
+func workerScriptContentHash(content string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(normalizeWorkerScriptContent(content))))
+}
+
+// suppressEquivalentWorkerScriptContent ignores differences in script content
+// which normalize to the same script, so they don't cause an upload.
+func suppressEquivalentWorkerScriptContent(k, old, new string, d *schema.ResourceData) bool {
+	return workerScriptContentHash(old) == workerScriptContentHash(new)
+}
+
+// resourceCloudflareWorkerScriptContentHash recomputes the content hash when
+// the content changes in a way that causes an upload.
+func resourceCloudflareWorkerScriptContentHash(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("content") {
+		return nil
+	}
+
+	if content, ok := d.GetOk("content"); ok && d.NewValueKnown("content") {
+		return d.SetNew("content_sha256", workerScriptContentHash(content.(string)))
+	}
+
+	return d.SetNewComputed("content_sha256")
+}
+
 type ScriptData struct {
 	// The script id will be the `name` for named script
 	// or the `zone_name` for zone-scoped scripts
@@ -131,6 +166,7 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	d.SetId(scriptData.ID)
+	d.Set("content_sha256", workerScriptContentHash(scriptBody))
 
 	return nil
 }
@@ -204,9 +240,15 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	if err := d.Set("content", r.Script); err != nil {
-		return diag.FromErr(fmt.Errorf("cannot set content: %w", err))
+	// the configured content is kept when the uploaded script only differs
+	// in whitespace, for example when the API strips a trailing newline.
+	contentHash := workerScriptContentHash(r.Script)
+	if contentHash != workerScriptContentHash(d.Get("content").(string)) {
+		if err := d.Set("content", r.Script); err != nil {
+			return diag.FromErr(fmt.Errorf("cannot set content: %w", err))
+		}
 	}
+	d.Set("content_sha256", contentHash)
 
 	if err := d.Set("kv_namespace_binding", kvNamespaceBindings); err != nil {
 		return diag.FromErr(fmt.Errorf("cannot set kv namespace bindings (%s): %w", d.Id(), err))
@@ -256,6 +298,8 @@ func resourceCloudflareWorkerScriptUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(errors.Wrap(err, "error updating worker script"))
 	}
 
+	d.Set("content_sha256", workerScriptContentHash(scriptBody))
+
 	return nil
 }
 
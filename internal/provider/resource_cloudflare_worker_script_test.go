@@ -172,3 +172,107 @@ func testAccCheckCloudflareWorkerScriptDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func TestSuppressEquivalentWorkerScriptContent(t *testing.T) {
+	testCases := map[string]struct {
+		old, new string
+		suppress bool
+	}{
+		"identical":                {scriptContent1, scriptContent1, true},
+		"trailing newline added":   {scriptContent1, scriptContent1 + "\n", true},
+		"trailing newline removed": {scriptContent1 + "\n", scriptContent1, true},
+		"windows line endings":     {"a;\r\nb;\r\n", "a;\nb;", true},
+		"multiple newlines":        {scriptContent1 + "\n\n", scriptContent1 + "\n", false},
+		"trailing space added":     {"a;\nb;", "a;  \nb;\t", false},
+		"template literal spacing": {"const s = `a  \nb`;", "const s = `a\nb`;", false},
+		"content changed":          {scriptContent1 + "\n", scriptContent2 + "\n", false},
+		"leading newline added":    {scriptContent1, "\n" + scriptContent1, false},
+		"indentation changed":      {"if (a) {\n  b;\n}", "if (a) {\n    b;\n}", false},
+		"newline inside content":   {"a\nb", "ab", false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := suppressEquivalentWorkerScriptContent("content", tc.old, tc.new, nil); got != tc.suppress {
+				t.Errorf("expected suppress to be %t, got %t", tc.suppress, got)
+			}
+		})
+	}
+}
+
+func TestWorkerScriptWhitespaceChangeDoesNotPlanUpload(t *testing.T) {
+	content := "addEventListener('fetch', event => {\n  event.respondWith(new Response('test 1'))\n})\n"
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":                     "example",
+			"name":                   "example",
+			"content":                content,
+			"content_sha256":         workerScriptContentHash(content),
+			"plain_text_binding.#":   "0",
+			"secret_text_binding.#":  "0",
+			"kv_namespace_binding.#": "0",
+			"webassembly_binding.#":  "0",
+		},
+	}
+
+	testCases := map[string]struct {
+		config     map[string]interface{}
+		wantUpload bool
+	}{
+		"line ending only change": {
+			config: map[string]interface{}{
+				"name":    "example",
+				"content": strings.ReplaceAll(strings.TrimSuffix(content, "\n"), "\n", "\r\n"),
+			},
+		},
+		"trailing whitespace change": {
+			config: map[string]interface{}{
+				"name":    "example",
+				"content": strings.ReplaceAll(content, "\n", "  \n"),
+			},
+			wantUpload: true,
+		},
+		"script change": {
+			config: map[string]interface{}{
+				"name":    "example",
+				"content": strings.Replace(content, "test 1", "test 2", 1),
+			},
+			wantUpload: true,
+		},
+		"binding change": {
+			config: map[string]interface{}{
+				"name":    "example",
+				"content": content + "\n",
+				"plain_text_binding": []interface{}{map[string]interface{}{
+					"name": "GREETING",
+					"text": "hello",
+				}},
+			},
+			wantUpload: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diff, err := resourceCloudflareWorkerScript().Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+			if err != nil {
+				t.Fatalf("unexpected error computing diff: %s", err)
+			}
+
+			planned := diff != nil && !diff.Empty()
+			if planned != tc.wantUpload {
+				t.Fatalf("expected an upload to be planned to be %t, got diff %v", tc.wantUpload, diff)
+			}
+			if !planned {
+				return
+			}
+
+			_, contentChanged := diff.GetAttribute("content")
+			_, hashChanged := diff.GetAttribute("content_sha256")
+			if contentChanged != hashChanged {
+				t.Errorf("expected content_sha256 to change together with content, got content %t and content_sha256 %t", contentChanged, hashChanged)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deviceSettingsPolicy holds the WARP client settings of a device settings
+// profile. Unset fields are omitted so that they are left unchanged.
+type deviceSettingsPolicy struct {
+	AllowModeSwitch     *bool                `json:"allow_mode_switch,omitempty"`
+	AllowUpdates        *bool                `json:"allow_updates,omitempty"`
+	AllowedToLeave      *bool                `json:"allowed_to_leave,omitempty"`
+	AutoConnect         *int                 `json:"auto_connect,omitempty"`
+	CaptivePortal       *int                 `json:"captive_portal,omitempty"`
+	DisableAutoFallback *bool                `json:"disable_auto_fallback,omitempty"`
+	ExcludeOfficeIps    *bool                `json:"exclude_office_ips,omitempty"`
+	SupportURL          *string              `json:"support_url,omitempty"`
+	SwitchLocked        *bool                `json:"switch_locked,omitempty"`
+	ServiceModeV2       *deviceServiceModeV2 `json:"service_mode_v2,omitempty"`
+}
+
+type deviceServiceModeV2 struct {
+	Mode string `json:"mode,omitempty"`
+	Port int    `json:"port,omitempty"`
+}
+
+func resourceCloudflareZeroTrustDeviceDefaultProfile() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZeroTrustDeviceDefaultProfileSchema(),
+		CreateContext: resourceCloudflareZeroTrustDeviceDefaultProfileUpdate, // Intentionally identical to Update as the default profile always exists
+		ReadContext:   resourceCloudflareZeroTrustDeviceDefaultProfileRead,
+		UpdateContext: resourceCloudflareZeroTrustDeviceDefaultProfileUpdate,
+		DeleteContext: resourceCloudflareZeroTrustDeviceDefaultProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZeroTrustDeviceDefaultProfileImport,
+		},
+		CustomizeDiff: resourceCloudflareZeroTrustDeviceDefaultProfileValidateServiceMode,
+		Description:   "Provides a Cloudflare resource for managing the default device settings profile of an account. The default profile applies to all devices that do not match a custom profile.",
+	}
+}
+
+// resourceCloudflareZeroTrustDeviceDefaultProfileValidateServiceMode rejects
+// the proxy service mode without a port, which would otherwise be sent to the
+// API as port 0.
+func resourceCloudflareZeroTrustDeviceDefaultProfileValidateServiceMode(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("service_mode_v2_mode").(string) != "proxy" {
+		return nil
+	}
+
+	// The port is computed, so an unset port only shows up as a null in the
+	// configuration; a port that is not known yet is left to apply.
+	port := getRawValue("service_mode_v2_port", d.GetRawConfig())
+	if !port.IsKnown() {
+		return nil
+	}
+
+	if port.IsNull() && d.Get("service_mode_v2_port").(int) == 0 {
+		return fmt.Errorf("`service_mode_v2_port` must be set when `service_mode_v2_mode` is \"proxy\"")
+	}
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustDeviceDefaultProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	var policy deviceSettingsPolicy
+	if err := rawAPIRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/devices/policy", accountID), nil, &policy); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading default device settings profile for account %q: %w", accountID, err))
+	}
+
+	if policy.AllowModeSwitch != nil {
+		d.Set("allow_mode_switch", *policy.AllowModeSwitch)
+	}
+	if policy.AllowUpdates != nil {
+		d.Set("allow_updates", *policy.AllowUpdates)
+	}
+	if policy.AllowedToLeave != nil {
+		d.Set("allowed_to_leave", *policy.AllowedToLeave)
+	}
+	if policy.AutoConnect != nil {
+		d.Set("auto_connect", *policy.AutoConnect)
+	}
+	if policy.CaptivePortal != nil {
+		d.Set("captive_portal", *policy.CaptivePortal)
+	}
+	if policy.DisableAutoFallback != nil {
+		d.Set("disable_auto_fallback", *policy.DisableAutoFallback)
+	}
+	if policy.ExcludeOfficeIps != nil {
+		d.Set("exclude_office_ips", *policy.ExcludeOfficeIps)
+	}
+	if policy.SupportURL != nil {
+		d.Set("support_url", *policy.SupportURL)
+	}
+	if policy.SwitchLocked != nil {
+		d.Set("switch_locked", *policy.SwitchLocked)
+	}
+	if policy.ServiceModeV2 != nil {
+		d.Set("service_mode_v2_mode", policy.ServiceModeV2.Mode)
+		d.Set("service_mode_v2_port", policy.ServiceModeV2.Port)
+	}
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustDeviceDefaultProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	policy := buildDeviceSettingsPolicy(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare default device settings profile from struct: %+v", policy))
+
+	if err := rawAPIRequest(ctx, client, http.MethodPatch, fmt.Sprintf("/accounts/%s/devices/policy", accountID), policy, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating default device settings profile for account %q: %w", accountID, err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceCloudflareZeroTrustDeviceDefaultProfileRead(ctx, d, meta)
+}
+
+func resourceCloudflareZeroTrustDeviceDefaultProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The default profile cannot be deleted, only removed from state. The
+	// settings applied to it remain in place.
+	tflog.Info(ctx, fmt.Sprintf("Removing default device settings profile for account %s from state; settings are left unchanged", d.Id()))
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareZeroTrustDeviceDefaultProfileImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountID := d.Id()
+
+	if accountID == "" {
+		return nil, fmt.Errorf("must provide account ID")
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare default device settings profile for account %s", accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(accountID)
+
+	resourceCloudflareZeroTrustDeviceDefaultProfileRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// buildDeviceSettingsPolicy only includes the settings that are configured so
+// that the remaining settings keep their current values.
+func buildDeviceSettingsPolicy(d *schema.ResourceData) deviceSettingsPolicy {
+	var policy deviceSettingsPolicy
+
+	if v, ok := d.GetOkExists("allow_mode_switch"); ok {
+		policy.AllowModeSwitch = cloudflare.BoolPtr(v.(bool))
+	}
+	if v, ok := d.GetOkExists("allow_updates"); ok {
+		policy.AllowUpdates = cloudflare.BoolPtr(v.(bool))
+	}
+	if v, ok := d.GetOkExists("allowed_to_leave"); ok {
+		policy.AllowedToLeave = cloudflare.BoolPtr(v.(bool))
+	}
+	if v, ok := d.GetOkExists("auto_connect"); ok {
+		policy.AutoConnect = cloudflare.IntPtr(v.(int))
+	}
+	if v, ok := d.GetOkExists("captive_portal"); ok {
+		policy.CaptivePortal = cloudflare.IntPtr(v.(int))
+	}
+	if v, ok := d.GetOkExists("disable_auto_fallback"); ok {
+		policy.DisableAutoFallback = cloudflare.BoolPtr(v.(bool))
+	}
+	if v, ok := d.GetOkExists("exclude_office_ips"); ok {
+		policy.ExcludeOfficeIps = cloudflare.BoolPtr(v.(bool))
+	}
+	if v, ok := d.GetOkExists("support_url"); ok {
+		policy.SupportURL = cloudflare.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOkExists("switch_locked"); ok {
+		policy.SwitchLocked = cloudflare.BoolPtr(v.(bool))
+	}
+	if v, ok := d.GetOk("service_mode_v2_mode"); ok {
+		policy.ServiceModeV2 = &deviceServiceModeV2{
+			Mode: v.(string),
+			Port: d.Get("service_mode_v2_port").(int),
+		}
+	}
+
+	return policy
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareZeroTrustDeviceDefaultProfile_AutoConnect(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zero_trust_device_default_profile.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZeroTrustDeviceDefaultProfileConfig(rnd, accountID, 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "auto_connect", "600"),
+					resource.TestCheckResourceAttr(name, "captive_portal", "180"),
+					resource.TestCheckResourceAttr(name, "switch_locked", "false"),
+				),
+			},
+			{
+				Config: testAccCloudflareZeroTrustDeviceDefaultProfileConfig(rnd, accountID, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "auto_connect", "0"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateId:     accountID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareZeroTrustDeviceDefaultProfileConfig(rnd, accountID string, autoConnect int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zero_trust_device_default_profile" "%[1]s" {
+  account_id     = "%[2]s"
+  auto_connect   = %[3]d
+  captive_portal = 180
+  switch_locked  = false
+}
+`, rnd, accountID, autoConnect)
+}
+
+func TestZeroTrustDeviceDefaultProfileOnlySendsConfiguredSettings(t *testing.T) {
+	var patchBody string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/devices/policy", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			body, _ := ioutil.ReadAll(r.Body)
+			patchBody = string(body)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"default": true, "auto_connect": 0, "captive_portal": 180, "allow_mode_switch": true, "switch_locked": false, "service_mode_v2": {"mode": "warp"}}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZeroTrustDeviceDefaultProfileSchema(), map[string]interface{}{
+		"account_id":   testAccCloudflareAccountID,
+		"auto_connect": 0,
+	})
+
+	if diags := resourceCloudflareZeroTrustDeviceDefaultProfileUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error updating default device profile: %v", diags)
+	}
+
+	if patchBody != `{"auto_connect":0}` {
+		t.Errorf("expected only the configured settings to be sent, got %s", patchBody)
+	}
+
+	if d.Id() != testAccCloudflareAccountID {
+		t.Errorf("expected ID to be the account ID, got %q", d.Id())
+	}
+
+	if got := d.Get("captive_portal").(int); got != 180 {
+		t.Errorf("expected captive_portal to be reconciled from the API, got %d", got)
+	}
+
+	if got := d.Get("service_mode_v2_mode").(string); got != "warp" {
+		t.Errorf("expected service_mode_v2_mode to be reconciled from the API, got %q", got)
+	}
+}
+
+func TestZeroTrustDeviceDefaultProfileProxyModeRequiresPort(t *testing.T) {
+	testCases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"proxy without port": {
+			config: map[string]interface{}{
+				"account_id":           testAccCloudflareAccountID,
+				"service_mode_v2_mode": "proxy",
+			},
+			err: "`service_mode_v2_port` must be set when `service_mode_v2_mode` is \"proxy\"",
+		},
+		"proxy with port": {
+			config: map[string]interface{}{
+				"account_id":           testAccCloudflareAccountID,
+				"service_mode_v2_mode": "proxy",
+				"service_mode_v2_port": 3000,
+			},
+		},
+		"warp without port": {
+			config: map[string]interface{}{
+				"account_id":           testAccCloudflareAccountID,
+				"service_mode_v2_mode": "warp",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := resourceCloudflareZeroTrustDeviceDefaultProfile().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deviceManagedNetwork is a WARP managed network used for TLS based network
+// detection.
+type deviceManagedNetwork struct {
+	NetworkID string                      `json:"network_id,omitempty"`
+	Name      string                      `json:"name"`
+	Type      string                      `json:"type"`
+	Config    *deviceManagedNetworkConfig `json:"config"`
+}
+
+type deviceManagedNetworkConfig struct {
+	TLSSockaddr string `json:"tls_sockaddr"`
+	Sha256      string `json:"sha256,omitempty"`
+}
+
+func resourceCloudflareZeroTrustDeviceManagedNetworks() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZeroTrustDeviceManagedNetworksSchema(),
+		CreateContext: resourceCloudflareZeroTrustDeviceManagedNetworksCreate,
+		ReadContext:   resourceCloudflareZeroTrustDeviceManagedNetworksRead,
+		UpdateContext: resourceCloudflareZeroTrustDeviceManagedNetworksUpdate,
+		DeleteContext: resourceCloudflareZeroTrustDeviceManagedNetworksDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZeroTrustDeviceManagedNetworksImport,
+		},
+		Description: "Provides a Cloudflare Device Managed Network resource. Device managed networks allow for building location-aware device settings policies.",
+	}
+}
+
+func resourceCloudflareZeroTrustDeviceManagedNetworksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	var network deviceManagedNetwork
+	err := rawAPIRequest(ctx, client, http.MethodGet, fmt.Sprintf("/accounts/%s/devices/networks/%s", accountID, d.Id()), nil, &network)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Device Managed Network %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Device Managed Network %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", network.Name)
+	d.Set("type", network.Type)
+	if err := d.Set("config", flattenDeviceManagedNetworkConfig(network.Config)); err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing Device Managed Network config: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustDeviceManagedNetworksCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	newNetwork := buildDeviceManagedNetwork(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Device Managed Network from struct: %+v", newNetwork))
+
+	var network deviceManagedNetwork
+	err := rawAPIRequest(ctx, client, http.MethodPost, fmt.Sprintf("/accounts/%s/devices/networks", accountID), newNetwork, &network)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Device Managed Network for account %q: %w", accountID, err))
+	}
+
+	if network.NetworkID == "" {
+		return diag.FromErr(fmt.Errorf("failed to find Device Managed Network ID in create response; resource was empty"))
+	}
+
+	d.SetId(network.NetworkID)
+
+	return resourceCloudflareZeroTrustDeviceManagedNetworksRead(ctx, d, meta)
+}
+
+func resourceCloudflareZeroTrustDeviceManagedNetworksUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	updatedNetwork := buildDeviceManagedNetwork(d)
+	updatedNetwork.NetworkID = d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Device Managed Network from struct: %+v", updatedNetwork))
+
+	err := rawAPIRequest(ctx, client, http.MethodPut, fmt.Sprintf("/accounts/%s/devices/networks/%s", accountID, d.Id()), updatedNetwork, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Device Managed Network for account %q: %w", accountID, err))
+	}
+
+	return resourceCloudflareZeroTrustDeviceManagedNetworksRead(ctx, d, meta)
+}
+
+func resourceCloudflareZeroTrustDeviceManagedNetworksDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Device Managed Network using ID: %s", d.Id()))
+
+	err := rawAPIRequest(ctx, client, http.MethodDelete, fmt.Sprintf("/accounts/%s/devices/networks/%s", accountID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Device Managed Network for account %q: %w", accountID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustDeviceManagedNetworksImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/deviceManagedNetworkID\"", d.Id())
+	}
+
+	accountID, networkID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Device Managed Network: id %s for account %s", networkID, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(networkID)
+
+	resourceCloudflareZeroTrustDeviceManagedNetworksRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildDeviceManagedNetwork(d *schema.ResourceData) deviceManagedNetwork {
+	network := deviceManagedNetwork{
+		Name: d.Get("name").(string),
+		Type: d.Get("type").(string),
+	}
+
+	if config, ok := d.GetOk("config.0"); ok {
+		c := config.(map[string]interface{})
+		network.Config = &deviceManagedNetworkConfig{
+			TLSSockaddr: c["tls_sockaddr"].(string),
+			Sha256:      c["sha256"].(string),
+		}
+	}
+
+	return network
+}
+
+func flattenDeviceManagedNetworkConfig(config *deviceManagedNetworkConfig) []interface{} {
+	if config == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"tls_sockaddr": config.TLSSockaddr,
+		"sha256":       config.Sha256,
+	}}
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareZeroTrustDeviceManagedNetworks_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zero_trust_device_managed_networks.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareZeroTrustDeviceManagedNetworksDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZeroTrustDeviceManagedNetworksConfig(rnd, accountID, "foobar.com:1234"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "type", "tls"),
+					resource.TestCheckResourceAttr(name, "config.0.tls_sockaddr", "foobar.com:1234"),
+					resource.TestCheckResourceAttr(name, "config.0.sha256", "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c"),
+				),
+			},
+			{
+				Config: testAccCloudflareZeroTrustDeviceManagedNetworksConfig(rnd, accountID, "192.0.2.1:443"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "config.0.tls_sockaddr", "192.0.2.1:443"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func TestZeroTrustDeviceManagedNetworksCreate(t *testing.T) {
+	var created deviceManagedNetwork
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/devices/networks", func(w http.ResponseWriter, r *http.Request) {
+		if !decodeTestRequestBody(t, w, r, &created) {
+			return
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"network_id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415"}}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/devices/networks/f174e90a-fafe-4643-bbbc-4a0ed4fc8415", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"network_id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415", "name": "office", "type": "tls", "config": {"tls_sockaddr": "foobar.com:1234"}}}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZeroTrustDeviceManagedNetworksSchema(), map[string]interface{}{
+		"account_id": testAccCloudflareAccountID,
+		"name":       "office",
+		"type":       "tls",
+		"config": []interface{}{map[string]interface{}{
+			"tls_sockaddr": "foobar.com:1234",
+		}},
+	})
+
+	if diags := resourceCloudflareZeroTrustDeviceManagedNetworksCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error creating device managed network: %v", diags)
+	}
+
+	if created.Name != "office" || created.Type != "tls" || created.Config == nil || created.Config.TLSSockaddr != "foobar.com:1234" {
+		t.Errorf("unexpected create request body: %+v", created)
+	}
+
+	if d.Id() != "f174e90a-fafe-4643-bbbc-4a0ed4fc8415" {
+		t.Errorf("expected ID to be set from the create response, got %q", d.Id())
+	}
+
+	if got := d.Get("config.0.tls_sockaddr").(string); got != "foobar.com:1234" {
+		t.Errorf("expected tls_sockaddr to be read back, got %q", got)
+	}
+}
+
+func testAccCloudflareZeroTrustDeviceManagedNetworksConfig(rnd, accountID, sockaddr string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zero_trust_device_managed_networks" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  type       = "tls"
+  config {
+    tls_sockaddr = "%[3]s"
+    sha256       = "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c"
+  }
+}
+`, rnd, accountID, sockaddr)
+}
+
+func testAccCheckCloudflareZeroTrustDeviceManagedNetworksDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_zero_trust_device_managed_networks" {
+			continue
+		}
+
+		err := rawAPIRequest(context.Background(), client, http.MethodGet, fmt.Sprintf("/accounts/%s/devices/networks/%s", rs.Primary.Attributes["account_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("device managed network still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	gatewayCertificateBindingStatusPendingDeployment = "pending_deployment"
+	gatewayCertificateBindingStatusAvailable         = "available"
+)
+
+// gatewayCertificate is a Cloudflare managed certificate Gateway can use to
+// inspect TLS traffic.
+type gatewayCertificate struct {
+	ID                 string     `json:"id,omitempty"`
+	ValidityPeriodDays int        `json:"validity_period_days,omitempty"`
+	BindingStatus      string     `json:"binding_status,omitempty"`
+	InUse              bool       `json:"in_use,omitempty"`
+	Certificate        string     `json:"certificate,omitempty"`
+	Fingerprint        string     `json:"fingerprint,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	ExpiresOn          *time.Time `json:"expires_on,omitempty"`
+}
+
+func resourceCloudflareZeroTrustGatewayCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZeroTrustGatewayCertificateSchema(),
+		CreateContext: resourceCloudflareZeroTrustGatewayCertificateCreate,
+		ReadContext:   resourceCloudflareZeroTrustGatewayCertificateRead,
+		UpdateContext: resourceCloudflareZeroTrustGatewayCertificateUpdate,
+		DeleteContext: resourceCloudflareZeroTrustGatewayCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZeroTrustGatewayCertificateImport,
+		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ComputedIf("expires_on", resourceCloudflareZeroTrustGatewayCertificateExpireDiff),
+			customdiff.ForceNewIf("expires_on", resourceCloudflareZeroTrustGatewayCertificateExpireDiff),
+		),
+		Description: "Provides a Cloudflare Zero Trust Gateway certificate resource. Gateway certificates are used to inspect TLS traffic.",
+	}
+}
+
+func gatewayCertificatesURI(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/gateway/certificates", accountID)
+}
+
+// resourceCloudflareZeroTrustGatewayCertificateExpireDiff regenerates the
+// certificate when it expires within `min_days_for_renewal`.
+func resourceCloudflareZeroTrustGatewayCertificateExpireDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+	// Read the prior value as `expires_on` is unknown once it's been marked
+	// as computed for the ForceNew check.
+	old, _ := d.GetChange("expires_on")
+	expiresOn := old.(string)
+	if d.Id() == "" || expiresOn == "" {
+		return false
+	}
+
+	expiry, err := time.Parse(time.RFC3339Nano, expiresOn)
+	if err != nil {
+		return false
+	}
+
+	return gatewayCertificateDueForRenewal(expiry, d.Get("min_days_for_renewal").(int), time.Now())
+}
+
+// gatewayCertificateDueForRenewal reports whether a certificate expiring at
+// expiry is within minDays of expiring at now. A minDays of 0 disables renewal.
+func gatewayCertificateDueForRenewal(expiry time.Time, minDays int, now time.Time) bool {
+	if minDays <= 0 {
+		return false
+	}
+
+	return now.Add(time.Duration(minDays) * 24 * time.Hour).After(expiry)
+}
+
+func resourceCloudflareZeroTrustGatewayCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	var certificate gatewayCertificate
+	err := rawAPIRequest(ctx, client, http.MethodGet, gatewayCertificatesURI(accountID)+"/"+d.Id(), nil, &certificate)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Gateway certificate %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Gateway certificate %q: %w", d.Id(), err))
+	}
+
+	d.Set("binding_status", certificate.BindingStatus)
+	d.Set("activate", gatewayCertificateActive(certificate))
+	d.Set("in_use", certificate.InUse)
+	d.Set("certificate", certificate.Certificate)
+	d.Set("fingerprint", certificate.Fingerprint)
+	d.Set("created_at", "")
+	d.Set("expires_on", "")
+
+	if certificate.CreatedAt != nil {
+		d.Set("created_at", certificate.CreatedAt.Format(time.RFC3339Nano))
+	}
+
+	if certificate.ExpiresOn != nil {
+		d.Set("expires_on", certificate.ExpiresOn.Format(time.RFC3339Nano))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustGatewayCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	newCertificate := gatewayCertificate{
+		ValidityPeriodDays: d.Get("validity_period_days").(int),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Gateway certificate from struct: %+v", newCertificate))
+
+	var certificate gatewayCertificate
+	err := rawAPIRequest(ctx, client, http.MethodPost, gatewayCertificatesURI(accountID), newCertificate, &certificate)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Gateway certificate for account %q: %w", accountID, err))
+	}
+
+	if certificate.ID == "" {
+		return diag.FromErr(fmt.Errorf("failed to find Gateway certificate ID in create response; resource was empty"))
+	}
+
+	d.SetId(certificate.ID)
+
+	if d.Get("activate").(bool) {
+		if err := setGatewayCertificateActivation(ctx, client, accountID, d.Id(), true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareZeroTrustGatewayCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareZeroTrustGatewayCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	if d.HasChange("activate") {
+		if err := setGatewayCertificateActivation(ctx, client, accountID, d.Id(), d.Get("activate").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareZeroTrustGatewayCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareZeroTrustGatewayCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Gateway certificate using ID: %s", d.Id()))
+
+	// Certificates have to be deactivated before they can be deleted.
+	if d.Get("activate").(bool) {
+		if err := setGatewayCertificateActivation(ctx, client, accountID, d.Id(), false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := rawAPIRequest(ctx, client, http.MethodDelete, gatewayCertificatesURI(accountID)+"/"+d.Id(), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Gateway certificate for account %q: %w", accountID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustGatewayCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/gatewayCertificateID\"", d.Id())
+	}
+
+	accountID, certificateID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Gateway certificate: id %s for account %s", certificateID, accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(certificateID)
+
+	resourceCloudflareZeroTrustGatewayCertificateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setGatewayCertificateActivation activates or deactivates the certificate
+// for use by Gateway.
+func setGatewayCertificateActivation(ctx context.Context, client *cloudflare.API, accountID, certificateID string, activate bool) error {
+	action := "deactivate"
+	if activate {
+		action = "activate"
+	}
+
+	if err := rawAPIRequest(ctx, client, http.MethodPost, fmt.Sprintf("%s/%s/%s", gatewayCertificatesURI(accountID), certificateID, action), struct{}{}, nil); err != nil {
+		return fmt.Errorf("failed to %s Gateway certificate %q: %w", action, certificateID, err)
+	}
+
+	return nil
+}
+
+// gatewayCertificateActive reports whether the certificate is activated,
+// including while it's still being deployed.
+func gatewayCertificateActive(certificate gatewayCertificate) bool {
+	return certificate.BindingStatus == gatewayCertificateBindingStatusAvailable ||
+		certificate.BindingStatus == gatewayCertificateBindingStatusPendingDeployment
+}
@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareZeroTrustGatewayCertificate_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Gateway
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zero_trust_gateway_certificate.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareZeroTrustGatewayCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZeroTrustGatewayCertificateConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "validity_period_days", "1826"),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+					resource.TestCheckResourceAttrSet(name, "fingerprint"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", accountID),
+				ImportStateVerifyIgnore: []string{"min_days_for_renewal", "validity_period_days"},
+			},
+		},
+	})
+}
+
+func TestZeroTrustGatewayCertificateDueForRenewal(t *testing.T) {
+	now := time.Date(2022, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		expiry  time.Time
+		minDays int
+		want    bool
+	}{
+		"renewal disabled":      {expiry: now.Add(time.Hour), minDays: 0, want: false},
+		"outside the window":    {expiry: now.Add(60 * 24 * time.Hour), minDays: 30, want: false},
+		"inside the window":     {expiry: now.Add(10 * 24 * time.Hour), minDays: 30, want: true},
+		"already expired":       {expiry: now.Add(-time.Hour), minDays: 1, want: true},
+		"exactly on the window": {expiry: now.Add(30 * 24 * time.Hour), minDays: 30, want: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := gatewayCertificateDueForRenewal(tc.expiry, tc.minDays, now); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestZeroTrustGatewayCertificateRenewsExpiringCertificate(t *testing.T) {
+	const (
+		oldID = "a1b2c3d4-0000-4000-8000-000000000001"
+		newID = "a1b2c3d4-0000-4000-8000-000000000002"
+	)
+
+	baseURI := "/accounts/" + testAccCloudflareAccountID + "/gateway/certificates"
+	newExpiry := time.Now().Add(1826 * 24 * time.Hour).UTC().Format(time.RFC3339)
+
+	var requests []string
+	mux := http.NewServeMux()
+	mux.HandleFunc(baseURI, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		var body gatewayCertificate
+		if !decodeTestRequestBody(t, w, r, &body) {
+			return
+		}
+		if body.ValidityPeriodDays != 1826 {
+			t.Errorf("expected validity_period_days of 1826, got %d", body.ValidityPeriodDays)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": %q}}`, newID)
+	})
+	mux.HandleFunc(baseURI+"/", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Path {
+		case baseURI + "/" + newID:
+			fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": %q, "binding_status": "pending_deployment", "expires_on": %q}}`, newID, newExpiry)
+		default:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+		}
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	state := &terraform.InstanceState{
+		ID: oldID,
+		Attributes: map[string]string{
+			"id":                   oldID,
+			"account_id":           testAccCloudflareAccountID,
+			"validity_period_days": "1826",
+			"activate":             "true",
+			"min_days_for_renewal": "30",
+			"binding_status":       "available",
+			"expires_on":           time.Now().Add(10 * 24 * time.Hour).UTC().Format(time.RFC3339),
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":           testAccCloudflareAccountID,
+		"activate":             true,
+		"min_days_for_renewal": 30,
+	})
+
+	resource := resourceCloudflareZeroTrustGatewayCertificate()
+	diff, err := resource.Diff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatalf("unexpected error planning: %s", err)
+	}
+
+	if diff == nil || !diff.RequiresNew() {
+		t.Fatalf("expected the expiring certificate to be replaced, got %+v", diff)
+	}
+
+	// With create_before_destroy the replacement is created and activated
+	// before the expiring certificate is deactivated and deleted.
+	createDiff, err := resource.Diff(context.Background(), nil, config, client)
+	if err != nil {
+		t.Fatalf("unexpected error planning the replacement: %s", err)
+	}
+
+	newState, diags := resource.Apply(context.Background(), nil, createDiff, client)
+	if diags.HasError() {
+		t.Fatalf("unexpected error creating the replacement: %v", diags)
+	}
+
+	if _, diags := resource.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, client); diags.HasError() {
+		t.Fatalf("unexpected error destroying the expiring certificate: %v", diags)
+	}
+
+	if newState.ID != newID {
+		t.Errorf("expected the regenerated certificate %q to be in state, got %q", newID, newState.ID)
+	}
+
+	if newState.Attributes["expires_on"] != newExpiry {
+		t.Errorf("expected expires_on to be read from the regenerated certificate, got %q", newState.Attributes["expires_on"])
+	}
+
+	expected := []string{
+		http.MethodPost + " " + baseURI,
+		http.MethodPost + " " + baseURI + "/" + newID + "/activate",
+		http.MethodGet + " " + baseURI + "/" + newID,
+		http.MethodPost + " " + baseURI + "/" + oldID + "/deactivate",
+		http.MethodDelete + " " + baseURI + "/" + oldID,
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Errorf("expected the new certificate to be activated before the old one is deactivated, expected requests %v, got %v", expected, requests)
+	}
+
+	// A certificate outside of the renewal window is left alone.
+	state.Attributes["expires_on"] = newExpiry
+	diff, err = resource.Diff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatalf("unexpected error planning: %s", err)
+	}
+
+	if diff != nil && diff.RequiresNew() {
+		t.Errorf("expected no replacement for a certificate outside of the renewal window, got %+v", diff)
+	}
+}
+
+func testAccCloudflareZeroTrustGatewayCertificateConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zero_trust_gateway_certificate" "%[1]s" {
+  account_id           = "%[2]s"
+  min_days_for_renewal = 30
+}
+`, rnd, accountID)
+}
+
+func testAccCheckCloudflareZeroTrustGatewayCertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_zero_trust_gateway_certificate" {
+			continue
+		}
+
+		err := rawAPIRequest(context.Background(), client, http.MethodGet, fmt.Sprintf("/accounts/%s/gateway/certificates/%s", rs.Primary.Attributes["account_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("gateway certificate still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// riskBehaviors are the risk behaviors of an account, keyed by behavior name.
+type riskBehaviors struct {
+	Behaviors map[string]riskBehavior `json:"behaviors"`
+}
+
+type riskBehavior struct {
+	Enabled   bool   `json:"enabled"`
+	RiskLevel string `json:"risk_level"`
+}
+
+func resourceCloudflareZeroTrustRiskBehavior() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZeroTrustRiskBehaviorSchema(),
+		CreateContext: resourceCloudflareZeroTrustRiskBehaviorUpdate, // Intentionally identical to Update as the behaviors always exist
+		ReadContext:   resourceCloudflareZeroTrustRiskBehaviorRead,
+		UpdateContext: resourceCloudflareZeroTrustRiskBehaviorUpdate,
+		DeleteContext: resourceCloudflareZeroTrustRiskBehaviorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZeroTrustRiskBehaviorImport,
+		},
+		Description: "Provides a Cloudflare resource for managing the risk behaviors of an account. Risk behaviors determine the risk score assigned to users.",
+	}
+}
+
+func riskBehaviorsURI(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/zt_risk_scoring/behaviors", accountID)
+}
+
+func resourceCloudflareZeroTrustRiskBehaviorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	var behaviors riskBehaviors
+	if err := rawAPIRequest(ctx, client, http.MethodGet, riskBehaviorsURI(accountID), nil, &behaviors); err != nil {
+		return diag.FromErr(fmt.Errorf("error reading risk behaviors for account %q: %w", accountID, err))
+	}
+
+	// only the behaviors which are managed are reconciled, all of them are
+	// read when nothing is managed yet, for example after an import.
+	var managed []string
+	for _, behavior := range d.Get("behavior").(*schema.Set).List() {
+		managed = append(managed, behavior.(map[string]interface{})["name"].(string))
+	}
+
+	if err := d.Set("behavior", flattenRiskBehaviors(behaviors.Behaviors, managed)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting risk behaviors: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustRiskBehaviorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get("account_id").(string)
+
+	behaviors := buildRiskBehaviors(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare risk behaviors from struct: %+v", behaviors))
+
+	if err := rawAPIRequest(ctx, client, http.MethodPut, riskBehaviorsURI(accountID), behaviors, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating risk behaviors for account %q: %w", accountID, err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceCloudflareZeroTrustRiskBehaviorRead(ctx, d, meta)
+}
+
+func resourceCloudflareZeroTrustRiskBehaviorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The behaviors cannot be deleted, only removed from state. Their settings
+	// remain in place.
+	tflog.Info(ctx, fmt.Sprintf("Removing risk behaviors for account %s from state; settings are left unchanged", d.Id()))
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareZeroTrustRiskBehaviorImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountID := d.Id()
+
+	if accountID == "" {
+		return nil, fmt.Errorf("must provide account ID")
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare risk behaviors for account %s", accountID))
+
+	d.Set("account_id", accountID)
+	d.SetId(accountID)
+
+	resourceCloudflareZeroTrustRiskBehaviorRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// buildRiskBehaviors only includes the configured behaviors so that the
+// remaining behaviors keep their current settings.
+func buildRiskBehaviors(d *schema.ResourceData) riskBehaviors {
+	behaviors := riskBehaviors{Behaviors: make(map[string]riskBehavior)}
+
+	for _, behavior := range d.Get("behavior").(*schema.Set).List() {
+		b := behavior.(map[string]interface{})
+		behaviors.Behaviors[b["name"].(string)] = riskBehavior{
+			Enabled:   b["enabled"].(bool),
+			RiskLevel: b["risk_level"].(string),
+		}
+	}
+
+	return behaviors
+}
+
+// flattenRiskBehaviors returns the named behaviors, or all of them when no
+// names are given.
+func flattenRiskBehaviors(behaviors map[string]riskBehavior, names []string) []interface{} {
+	if len(names) == 0 {
+		for name := range behaviors {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	var flattened []interface{}
+	for _, name := range names {
+		behavior, ok := behaviors[name]
+		if !ok {
+			continue
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"name":       name,
+			"enabled":    behavior.Enabled,
+			"risk_level": behavior.RiskLevel,
+		})
+	}
+
+	return flattened
+}
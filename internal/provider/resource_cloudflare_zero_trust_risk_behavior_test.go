@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccCloudflareZeroTrustRiskBehavior_RiskLevel(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		defer func(apiToken string) {
+			os.Setenv("CLOUDFLARE_API_TOKEN", apiToken)
+		}(os.Getenv("CLOUDFLARE_API_TOKEN"))
+		os.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zero_trust_risk_behavior.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccessAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareZeroTrustRiskBehaviorConfig(rnd, accountID, "severe"),
+				ExpectError: regexp.MustCompile(`expected behavior.\d+.risk_level to be one of \[low medium high\], got severe`),
+			},
+			{
+				Config: testAccCloudflareZeroTrustRiskBehaviorConfig(rnd, accountID, "high"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "behavior.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "behavior.*", map[string]string{
+						"name":       "imp_travel",
+						"enabled":    "true",
+						"risk_level": "high",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZeroTrustRiskBehaviorConfig(rnd, accountID, riskLevel string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zero_trust_risk_behavior" "%[1]s" {
+  account_id = "%[2]s"
+
+  behavior {
+    name       = "imp_travel"
+    enabled    = true
+    risk_level = "%[3]s"
+  }
+}
+`, rnd, accountID, riskLevel)
+}
+
+func TestZeroTrustRiskBehaviorOnlyManagesConfiguredBehaviors(t *testing.T) {
+	behaviors := map[string]riskBehavior{
+		"imp_travel":            {Enabled: false, RiskLevel: "low"},
+		"high_dlp":              {Enabled: true, RiskLevel: "medium"},
+		"sentinelone_infection": {Enabled: true, RiskLevel: "high"},
+	}
+	var updated riskBehaviors
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/"+testAccCloudflareAccountID+"/zt_risk_scoring/behaviors", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			if !decodeTestRequestBody(t, w, r, &updated) {
+				return
+			}
+			for name, behavior := range updated.Behaviors {
+				behaviors[name] = behavior
+			}
+		}
+
+		result, _ := json.Marshal(riskBehaviors{Behaviors: behaviors})
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, result)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZeroTrustRiskBehaviorSchema(), map[string]interface{}{
+		"account_id": testAccCloudflareAccountID,
+		"behavior": []interface{}{map[string]interface{}{
+			"name":       "imp_travel",
+			"enabled":    true,
+			"risk_level": "high",
+		}},
+	})
+
+	if diags := resourceCloudflareZeroTrustRiskBehaviorUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := riskBehaviors{Behaviors: map[string]riskBehavior{"imp_travel": {Enabled: true, RiskLevel: "high"}}}
+	if !reflect.DeepEqual(updated, expected) {
+		t.Errorf("expected only the configured behavior to be sent, got %+v", updated)
+	}
+
+	if got := d.Get("behavior").(*schema.Set).Len(); got != 1 {
+		t.Errorf("expected only the configured behavior in state, got %d behaviors", got)
+	}
+
+	imported := schema.TestResourceDataRaw(t, resourceCloudflareZeroTrustRiskBehaviorSchema(), map[string]interface{}{})
+	imported.SetId(testAccCloudflareAccountID)
+	if _, err := resourceCloudflareZeroTrustRiskBehaviorImport(context.Background(), imported, client); err != nil {
+		t.Fatalf("unexpected error importing: %s", err)
+	}
+	if got := imported.Get("behavior").(*schema.Set).Len(); got != 3 {
+		t.Errorf("expected all behaviors to be read on import, got %d", got)
+	}
+}
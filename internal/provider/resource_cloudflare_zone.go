@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"golang.org/x/net/idna"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -75,6 +77,32 @@ var ratePlans = map[string]subscriptionData{
 	},
 }
 
+// canonicalZonePlanID maps the different representations of a rate plan, such
+// as its legacy ID in another case or its subscription name, to the plan ID
+// used in the configuration. Unknown plans are returned as is.
+func canonicalZonePlanID(plan string) string {
+	if _, ok := ratePlans[strings.ToLower(plan)]; ok {
+		return strings.ToLower(plan)
+	}
+
+	for id, ratePlan := range ratePlans {
+		if strings.EqualFold(ratePlan.Name, plan) {
+			return id
+		}
+	}
+
+	return plan
+}
+
+// zonePlanID returns the canonical plan ID of the rate plan of a zone.
+func zonePlanID(plan cloudflare.ZonePlan) string {
+	if plan.LegacyID != "" {
+		return canonicalZonePlanID(plan.LegacyID)
+	}
+
+	return canonicalZonePlanID(plan.Name)
+}
+
 func resourceCloudflareZone() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareZoneSchema(),
@@ -85,6 +113,13 @@ func resourceCloudflareZone() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: customdiff.All(
+			resourceCloudflareZoneValidateAccountChange,
+			resourceCloudflareZoneWaitForActivationDiff,
+		),
 	}
 }
 
@@ -97,6 +132,9 @@ func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, m
 	account := cloudflare.Account{
 		ID: client.AccountID,
 	}
+	if accountID, ok := d.GetOk("account_id"); ok {
+		account.ID = accountID.(string)
+	}
 
 	tflog.Info(ctx, fmt.Sprintf("Creating Cloudflare Zone: name %s", zoneName))
 
@@ -108,13 +146,11 @@ func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(zone.ID)
 
-	if paused, ok := d.GetOk("paused"); ok {
-		if paused.(bool) == true {
-			_, err := client.ZoneSetPaused(ctx, zone.ID, paused.(bool))
+	if d.Get("paused").(bool) {
+		_, err := client.ZoneSetPaused(ctx, zone.ID, true)
 
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("error updating zone_id %q: %w", zone.ID, err))
-			}
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating zone_id %q: %w", zone.ID, err))
 		}
 	}
 
@@ -131,6 +167,10 @@ func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// The verification key only exists once the zone has been created so
+	// `wait_for_activation` is only honoured on update, after the
+	// `verification_record` has had a chance to be published.
+
 	return resourceCloudflareZoneRead(ctx, d, meta)
 }
 
@@ -157,11 +197,12 @@ func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, met
 	// from `zone.PlanPending` instead to account for paid plans.
 	var plan string
 	if zone.Status == "pending" && zone.PlanPending.LegacyID != "" {
-		plan = zone.PlanPending.LegacyID
+		plan = zonePlanID(zone.PlanPending)
 	} else {
-		plan = zone.Plan.LegacyID
+		plan = zonePlanID(zone.Plan)
 	}
 
+	d.Set("account_id", zone.Account.ID)
 	d.Set("paused", zone.Paused)
 	d.Set("vanity_name_servers", zone.VanityNS)
 	d.Set("status", zone.Status)
@@ -171,6 +212,7 @@ func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("zone", zone.Name)
 	d.Set("plan", plan)
 	d.Set("verification_key", zone.VerificationKey)
+	d.Set("verification_record", flattenZoneVerificationRecord(zone))
 
 	return nil
 }
@@ -182,10 +224,13 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	log.Printf("[INFO] Updating Cloudflare Zone: id %s", zoneID)
 
-	if paused, ok := d.GetOkExists("paused"); ok && d.HasChange("paused") {
-		log.Printf("[DEBUG] _ paused")
+	// Pausing is a regular zone edit so it is applied in place, including
+	// when `paused` is removed from the configuration and falls back to false.
+	if d.HasChange("paused") {
+		paused := d.Get("paused").(bool)
+		log.Printf("[DEBUG] Setting paused to %t for zone ID %s", paused, zoneID)
 
-		_, err := client.ZoneSetPaused(ctx, zoneID, paused.(bool))
+		_, err := client.ZoneSetPaused(ctx, zoneID, paused)
 
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error setting paused for zone ID %q: %w", zoneID, err))
@@ -204,7 +249,7 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 	// check the `status` field and should it be pending, use the `LegacyID`
 	// from `zone.PlanPending` instead to account for paid plans.
 	if zone.Status == "pending" && zone.PlanPending.Name != "" {
-		d.Set("plan", zone.PlanPending.LegacyID)
+		d.Set("plan", zonePlanID(zone.PlanPending))
 	}
 
 	if change := d.HasChange("plan"); change {
@@ -212,7 +257,7 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 		// the subscription needs to be created, not modified despite the resource
 		// already existing.
 		existingPlan, newPlan := d.GetChange("plan")
-		wasFreePlan := existingPlan.(string) == "free"
+		wasFreePlan := canonicalZonePlanID(existingPlan.(string)) == planIDFree
 		planID := newPlan.(string)
 
 		if err := setRatePlan(ctx, client, zoneID, planID, wasFreePlan, d); err != nil {
@@ -220,9 +265,52 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.Get("wait_for_activation").(bool) && d.Get("type").(string) == "partial" && zone.Status == "pending" {
+		if err := waitForZoneActivation(ctx, client, zoneID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCloudflareZoneRead(ctx, d, meta)
 }
 
+// resourceCloudflareZoneWaitForActivationDiff plans an update of `status` for
+// a pending zone that has `wait_for_activation` set, so the next apply waits
+// for it to become active even when the configuration is unchanged.
+func resourceCloudflareZoneWaitForActivationDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("wait_for_activation").(bool) || d.Get("type").(string) != "partial" {
+		return nil
+	}
+
+	if status, _ := d.GetChange("status"); status.(string) != "pending" {
+		return nil
+	}
+
+	return d.SetNewComputed("status")
+}
+
+// resourceCloudflareZoneValidateAccountChange stops a change of `account_id`
+// from being planned. Zones can't be moved between accounts using the API and
+// replacing the zone instead would delete it along with all of its
+// configuration.
+func resourceCloudflareZoneValidateAccountChange(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("account_id") || !d.NewValueKnown("account_id") {
+		return nil
+	}
+
+	oldAccountID, newAccountID := d.GetChange("account_id")
+	return validateZoneAccountChange(d.Get("zone").(string), oldAccountID.(string), newAccountID.(string))
+}
+
+func validateZoneAccountChange(zoneName, oldAccountID, newAccountID string) error {
+	if oldAccountID == "" || newAccountID == "" || oldAccountID == newAccountID {
+		return nil
+	}
+
+	return fmt.Errorf("zone %q can't be moved from account %q to account %q: moving a zone between accounts isn't supported by the API and recreating it would delete the zone and its configuration. "+
+		"Move the zone manually, see https://developers.cloudflare.com/fundamentals/get-started/basic-tasks/manage-domains/move-domain/, then update `account_id` to match", zoneName, oldAccountID, newAccountID)
+}
+
 func resourceCloudflareZoneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Id()
@@ -249,6 +337,45 @@ func flattenMeta(d *schema.ResourceData, meta cloudflare.ZoneMeta) map[string]in
 	return cfg
 }
 
+// flattenZoneVerificationRecord returns the TXT record that verifies ownership
+// of a partial zone.
+func flattenZoneVerificationRecord(zone cloudflare.Zone) []map[string]interface{} {
+	if zone.VerificationKey == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"name":  "cloudflare-verify." + zone.Name,
+		"type":  "TXT",
+		"value": zone.VerificationKey,
+	}}
+}
+
+// waitForZoneActivation requests an activation check for the zone and then
+// polls until it is active.
+func waitForZoneActivation(ctx context.Context, client *cloudflare.API, zoneID string, timeout time.Duration) error {
+	// The activation check is rate limited so a failure here isn't fatal;
+	// the zone is still checked periodically by Cloudflare.
+	if _, err := client.ZoneActivationCheck(ctx, zoneID); err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Activation check for zone %s failed: %s", zoneID, err))
+	}
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		zone, err := client.ZoneDetails(ctx, zoneID)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error finding Zone %q: %w", zoneID, err))
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Zone %s activation status: %s", zoneID, zone.Status))
+
+		if zone.Status != "active" {
+			return resource.RetryableError(fmt.Errorf("zone %q is %s, waiting for it to become active", zoneID, zone.Status))
+		}
+
+		return nil
+	})
+}
+
 // setRatePlan handles the internals of creating or updating a zone
 // subscription rate plan.
 func setRatePlan(ctx context.Context, client *cloudflare.API, zoneID, planID string, isNewPlan bool, d *schema.ResourceData) error {
@@ -0,0 +1,418 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZoneSetting() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneSettingSchema(),
+		CreateContext: resourceCloudflareZoneSettingCreate,
+		ReadContext:   resourceCloudflareZoneSettingRead,
+		UpdateContext: resourceCloudflareZoneSettingUpdate,
+		DeleteContext: resourceCloudflareZoneSettingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneSettingImport,
+		},
+		CustomizeDiff: resourceCloudflareZoneSettingValidateValue,
+		Description:   "Provides a resource which manages a single Cloudflare zone setting, without taking ownership of the remaining settings of the zone.",
+	}
+}
+
+func resourceCloudflareZoneSettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	settingID := d.Get("setting_id").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Creating zone setting %q for zone %q", settingID, zoneID))
+
+	initialSetting, err := getZoneSingleSetting(ctx, client, zoneID, settingID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading initial value of zone setting %q for zone %q: %w", settingID, zoneID, err))
+	}
+	d.Set("initial_value", flattenZoneSettingValue(initialSetting.Value))
+
+	d.SetId(zoneID + "/" + settingID)
+
+	return resourceCloudflareZoneSettingUpdate(ctx, d, meta)
+}
+
+func resourceCloudflareZoneSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	settingID := d.Get("setting_id").(string)
+
+	setting, err := getZoneSingleSetting(ctx, client, zoneID, settingID)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Zone %q not found, removing zone setting %q from state", zoneID, settingID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading zone setting %q for zone %q: %w", settingID, zoneID, err))
+	}
+
+	// a setting that isn't editable keeps its configured value, the update
+	// warned about it rather than changing it.
+	configured := d.Get("value").(string) != ""
+	for _, block := range zoneSettingBlocks {
+		configured = configured || len(d.Get(block).([]interface{})) > 0
+	}
+	if !setting.Editable && configured {
+		tflog.Warn(ctx, fmt.Sprintf("Zone setting %q for zone %q is not editable, keeping the configured value", settingID, zoneID))
+		return nil
+	}
+
+	if settingID == zoneSettingAutomaticPlatformOptimization {
+		apo, err := expandZoneSettingValue(settingID, flattenZoneSettingValue(setting.Value))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("automatic_platform_optimization", flattenZoneSettingAPO(apo.(zoneSettingAPOValue))); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting automatic_platform_optimization: %w", err))
+		}
+		return nil
+	}
+
+	if settingID == zoneSettingSSLRecommender {
+		recommender, err := expandZoneSettingValue(settingID, flattenZoneSettingValue(setting.Value))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("ssl_recommender", []interface{}{map[string]interface{}{
+			"enabled": recommender.(zoneSettingSSLRecommenderValue).Enabled,
+		}}); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting ssl_recommender: %w", err))
+		}
+		return nil
+	}
+
+	d.Set("value", flattenZoneSettingValue(setting.Value))
+
+	return nil
+}
+
+func resourceCloudflareZoneSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	settingID := d.Get("setting_id").(string)
+
+	setting, err := getZoneSingleSetting(ctx, client, zoneID, settingID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading zone setting %q for zone %q: %w", settingID, zoneID, err))
+	}
+
+	var diags diag.Diagnostics
+	if !setting.Editable {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Zone setting %q is not editable for zone %q", settingID, zoneID),
+			Detail:   "The setting was not changed. It will be applied once it becomes editable, for example when the zone's plan allows it.",
+		})
+	} else {
+		settingValue := d.Get("value").(string)
+		switch settingID {
+		case zoneSettingAutomaticPlatformOptimization:
+			settingValue = flattenZoneSettingValue(expandZoneSettingAPO(d))
+		case zoneSettingSSLRecommender:
+			settingValue = flattenZoneSettingValue(zoneSettingSSLRecommenderValue{
+				Enabled: d.Get("ssl_recommender.0.enabled").(bool),
+			})
+		}
+
+		if err := updateZoneSingleSettingValue(ctx, client, zoneID, settingID, settingValue); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return append(diags, resourceCloudflareZoneSettingRead(ctx, d, meta)...)
+}
+
+func resourceCloudflareZoneSettingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get("zone_id").(string)
+	settingID := d.Get("setting_id").(string)
+	initialValue := d.Get("initial_value").(string)
+
+	if initialValue == "" {
+		tflog.Info(ctx, fmt.Sprintf("No initial value recorded for zone setting %q in zone %q, leaving it as is", settingID, zoneID))
+		return nil
+	}
+
+	setting, err := getZoneSingleSetting(ctx, client, zoneID, settingID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading zone setting %q for zone %q: %w", settingID, zoneID, err))
+	}
+
+	if !setting.Editable {
+		tflog.Warn(ctx, fmt.Sprintf("Zone setting %q for zone %q is not editable, leaving it as is", settingID, zoneID))
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Restoring zone setting %q for zone %q to %q", settingID, zoneID, initialValue))
+
+	if err := updateZoneSingleSettingValue(ctx, client, zoneID, settingID, initialValue); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneSettingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idAttr := strings.SplitN(d.Id(), "/", 2)
+	if len(idAttr) != 2 || idAttr[0] == "" || idAttr[1] == "" {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/settingID\"", d.Id())
+	}
+
+	zoneID, settingID := idAttr[0], idAttr[1]
+	if !contains(granularZoneSettings, settingID) {
+		return nil, fmt.Errorf("zone setting %q can't be managed with cloudflare_zone_setting, must be one of %s", settingID, strings.Join(granularZoneSettings, ", "))
+	}
+
+	client := meta.(*cloudflare.API)
+	setting, err := getZoneSingleSetting(ctx, client, zoneID, settingID)
+	if err != nil {
+		return nil, fmt.Errorf("error reading zone setting %q for zone %q: %w", settingID, zoneID, err)
+	}
+
+	d.Set("zone_id", zoneID)
+	d.Set("setting_id", settingID)
+	// the value found on import is what the setting is restored to on destroy.
+	d.Set("initial_value", flattenZoneSettingValue(setting.Value))
+
+	resourceCloudflareZoneSettingRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceCloudflareZoneSettingValidateValue(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("setting_id") {
+		return nil
+	}
+
+	settingID := d.Get("setting_id").(string)
+
+	for _, block := range zoneSettingBlocks {
+		configured := len(d.Get(block).([]interface{})) > 0
+		if block != settingID && configured {
+			return fmt.Errorf("`%s` can only be set for zone setting %q", block, block)
+		}
+		if block == settingID && !configured {
+			return fmt.Errorf("zone setting %q must be configured using the `%s` block", settingID, block)
+		}
+	}
+
+	if contains(zoneSettingBlocks, settingID) {
+		if d.Get("value").(string) != "" {
+			return fmt.Errorf("zone setting %q can't be configured using `value`", settingID)
+		}
+	}
+
+	if settingID == zoneSettingAutomaticPlatformOptimization {
+		if !d.NewValueKnown("zone_id") || !d.NewValueKnown("automatic_platform_optimization.0.hostnames") {
+			return nil
+		}
+		hostnames := expandInterfaceToStringList(d.Get("automatic_platform_optimization.0.hostnames").(*schema.Set).List())
+		if len(hostnames) == 0 {
+			return nil
+		}
+
+		zoneID := d.Get("zone_id").(string)
+		zone, err := meta.(*cloudflare.API).ZoneDetails(ctx, zoneID)
+		if err != nil {
+			return fmt.Errorf("error finding Zone %q to validate hostnames: %w", zoneID, err)
+		}
+		return validateZoneHostnames(zone.Name, hostnames)
+	}
+
+	if contains(zoneSettingBlocks, settingID) {
+		return nil
+	}
+
+	if !d.NewValueKnown("value") {
+		return nil
+	}
+
+	value := d.Get("value").(string)
+	if value == "" {
+		return fmt.Errorf("`value` must be set for zone setting %q", settingID)
+	}
+
+	_, err := expandZoneSettingValue(settingID, value)
+	return err
+}
+
+func updateZoneSingleSettingValue(ctx context.Context, client *cloudflare.API, zoneID, settingID, value string) error {
+	settingValue, err := expandZoneSettingValue(settingID, value)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting zone setting %q for zone %q to %#v", settingID, zoneID, settingValue))
+
+	// settings using `enabled` are updated with it rather than `value`.
+	if recommender, ok := settingValue.(zoneSettingSSLRecommenderValue); ok {
+		if err := rawAPIRequest(ctx, client, http.MethodPatch, zoneSingleSettingURI(zoneID, settingID), recommender, nil); err != nil {
+			return fmt.Errorf("error updating zone setting %q for zone %q: %w", settingID, zoneID, err)
+		}
+		return nil
+	}
+
+	_, err = client.UpdateZoneSingleSetting(ctx, zoneID, settingID, cloudflare.ZoneSetting{ID: settingID, Value: settingValue})
+	if err != nil {
+		return fmt.Errorf("error updating zone setting %q for zone %q: %w", settingID, zoneID, err)
+	}
+
+	return nil
+}
+
+// zoneSingleSetting extends cloudflare.ZoneSetting with the `enabled` field
+// which settings like `ssl_recommender` use instead of `value`.
+type zoneSingleSetting struct {
+	cloudflare.ZoneSetting
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+func zoneSingleSettingURI(zoneID, settingID string) string {
+	return fmt.Sprintf("/zones/%s/settings/%s", zoneID, settingID)
+}
+
+// getZoneSingleSetting reads a zone setting. The `enabled` field of
+// `ssl_recommender` is returned as its value so it can be handled like the
+// value of any other setting.
+func getZoneSingleSetting(ctx context.Context, client *cloudflare.API, zoneID, settingID string) (cloudflare.ZoneSetting, error) {
+	if settingID != zoneSettingSSLRecommender {
+		return client.ZoneSingleSetting(ctx, zoneID, settingID)
+	}
+
+	var setting zoneSingleSetting
+	if err := rawAPIRequest(ctx, client, http.MethodGet, zoneSingleSettingURI(zoneID, settingID), nil, &setting); err != nil {
+		return cloudflare.ZoneSetting{}, err
+	}
+	setting.Value = zoneSettingSSLRecommenderValue{Enabled: setting.Enabled != nil && *setting.Enabled}
+
+	return setting.ZoneSetting, nil
+}
+
+// expandZoneSettingValue converts the string value of a granular zone setting
+// into the type the API expects for it and validates it using the rules of the
+// matching `cloudflare_zone_settings_override` setting. The values of
+// `automatic_platform_optimization` and `ssl_recommender` are JSON encoded.
+func expandZoneSettingValue(settingID, value string) (interface{}, error) {
+	if settingID == zoneSettingSSLRecommender {
+		var recommender zoneSettingSSLRecommenderValue
+		if err := json.Unmarshal([]byte(value), &recommender); err != nil {
+			return nil, fmt.Errorf("value of zone setting %q must be a JSON object: %w", settingID, err)
+		}
+		return recommender, nil
+	}
+
+	if settingID == zoneSettingAutomaticPlatformOptimization {
+		apo := zoneSettingAPOValue{Hostnames: []string{}}
+		if err := json.Unmarshal([]byte(value), &apo); err != nil {
+			return nil, fmt.Errorf("value of zone setting %q must be a JSON object: %w", settingID, err)
+		}
+		if apo.Hostnames == nil {
+			apo.Hostnames = []string{}
+		}
+		return apo, nil
+	}
+
+	settingSchema, ok := resourceCloudflareZoneSettingsSchema[settingID]
+	if !ok {
+		return nil, fmt.Errorf("zone setting %q can't be managed with cloudflare_zone_setting", settingID)
+	}
+
+	var settingValue interface{} = value
+	if settingSchema.Type == schema.TypeInt {
+		intValue, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("value of zone setting %q must be a number, got %q", settingID, value)
+		}
+		settingValue = intValue
+	}
+
+	if settingSchema.ValidateFunc != nil {
+		if _, errs := settingSchema.ValidateFunc(settingValue, settingID); len(errs) > 0 {
+			return nil, errs[0]
+		}
+	}
+
+	return settingValue, nil
+}
+
+// flattenZoneSettingValue converts a zone setting value returned by the API
+// into its string representation.
+func flattenZoneSettingValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return ""
+	case map[string]interface{}, zoneSettingAPOValue, zoneSettingSSLRecommenderValue:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(encoded)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// zoneSettingAPOValue is the value of the `automatic_platform_optimization`
+// zone setting.
+type zoneSettingAPOValue struct {
+	Enabled           bool     `json:"enabled"`
+	CacheByDeviceType bool     `json:"cache_by_device_type"`
+	CF                bool     `json:"cf"`
+	Wordpress         bool     `json:"wordpress"`
+	WPPlugin          bool     `json:"wp_plugin"`
+	Hostnames         []string `json:"hostnames"`
+}
+
+// zoneSettingSSLRecommenderValue is the value of the `ssl_recommender` zone
+// setting.
+type zoneSettingSSLRecommenderValue struct {
+	Enabled bool `json:"enabled"`
+}
+
+func expandZoneSettingAPO(d *schema.ResourceData) zoneSettingAPOValue {
+	hostnames := expandInterfaceToStringList(d.Get("automatic_platform_optimization.0.hostnames").(*schema.Set).List())
+	sort.Strings(hostnames)
+
+	return zoneSettingAPOValue{
+		Enabled:           d.Get("automatic_platform_optimization.0.enabled").(bool),
+		CacheByDeviceType: d.Get("automatic_platform_optimization.0.cache_by_device_type").(bool),
+		CF:                d.Get("automatic_platform_optimization.0.cf").(bool),
+		Wordpress:         d.Get("automatic_platform_optimization.0.wordpress").(bool),
+		WPPlugin:          d.Get("automatic_platform_optimization.0.wp_plugin").(bool),
+		Hostnames:         hostnames,
+	}
+}
+
+func flattenZoneSettingAPO(apo zoneSettingAPOValue) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"enabled":              apo.Enabled,
+		"cache_by_device_type": apo.CacheByDeviceType,
+		"cf":                   apo.CF,
+		"wordpress":            apo.Wordpress,
+		"wp_plugin":            apo.WPPlugin,
+		"hostnames":            apo.Hostnames,
+	}}
+}
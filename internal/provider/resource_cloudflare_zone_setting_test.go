@@ -0,0 +1,538 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareZoneSetting_CacheLevel(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_setting." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSettingConfig(rnd, zoneID, "cache_level", "simplified"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "setting_id", "cache_level"),
+					resource.TestCheckResourceAttr(name, "value", "simplified"),
+					resource.TestCheckResourceAttrSet(name, "initial_value"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneSettingConfig(rnd, zoneID, "cache_level", "basic"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "value", "basic"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateId:           zoneID + "/cache_level",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"initial_value"},
+			},
+			{
+				Config:      testAccCloudflareZoneSettingConfig(rnd, zoneID, "cache_level", "everything"),
+				ExpectError: regexp.MustCompile(`expected cache_level to be one of \[aggressive basic simplified\], got everything`),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZoneSetting_BrowserCacheTTL(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_setting." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSettingConfig(rnd, zoneID, "browser_cache_ttl", "7200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "setting_id", "browser_cache_ttl"),
+					resource.TestCheckResourceAttr(name, "value", "7200"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneSettingConfig(rnd, zoneID, "browser_cache_ttl", "14400"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "value", "14400"),
+				),
+			},
+			{
+				Config:      testAccCloudflareZoneSettingConfig(rnd, zoneID, "browser_cache_ttl", "1000"),
+				ExpectError: regexp.MustCompile(`expected browser_cache_ttl to be one of`),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZoneSetting_AutomaticPlatformOptimization(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_setting." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDomain(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSettingConfigAPO(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "setting_id", "automatic_platform_optimization"),
+					resource.TestCheckResourceAttr(name, "automatic_platform_optimization.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "automatic_platform_optimization.0.cache_by_device_type", "true"),
+					resource.TestCheckResourceAttr(name, "automatic_platform_optimization.0.cf", "true"),
+					resource.TestCheckResourceAttr(name, "automatic_platform_optimization.0.wordpress", "true"),
+					resource.TestCheckResourceAttr(name, "automatic_platform_optimization.0.wp_plugin", "true"),
+					resource.TestCheckResourceAttr(name, "automatic_platform_optimization.0.hostnames.#", "2"),
+					resource.TestCheckResourceAttrSet(name, "initial_value"),
+				),
+			},
+			{
+				Config:      testAccCloudflareZoneSettingConfigAPO(rnd, zoneID, "example.net"),
+				ExpectError: regexp.MustCompile(`hostname "example.net" doesn't belong to zone`),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZoneSetting_SSLRecommender(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_setting." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSettingConfigSSLRecommender(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "setting_id", "ssl_recommender"),
+					resource.TestCheckResourceAttr(name, "ssl_recommender.0.enabled", "true"),
+					resource.TestCheckResourceAttrSet(name, "initial_value"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneSettingConfigSSLRecommender(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "ssl_recommender.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneSettingConfigSSLRecommender(resourceName, zoneID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_setting" "%[1]s" {
+  zone_id    = "%[2]s"
+  setting_id = "ssl_recommender"
+
+  ssl_recommender {
+    enabled = %[3]t
+  }
+}`, resourceName, zoneID, enabled)
+}
+
+func testAccCloudflareZoneSettingConfigAPO(resourceName, zoneID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_setting" "%[1]s" {
+  zone_id    = "%[2]s"
+  setting_id = "automatic_platform_optimization"
+
+  automatic_platform_optimization {
+    enabled              = true
+    cache_by_device_type = true
+    cf                   = true
+    wordpress            = true
+    wp_plugin            = true
+    hostnames            = ["%[3]s", "www.%[3]s"]
+  }
+}`, resourceName, zoneID, domain)
+}
+
+func testAccCloudflareZoneSettingConfig(resourceName, zoneID, settingID, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_setting" "%[1]s" {
+  zone_id    = "%[2]s"
+  setting_id = "%[3]s"
+  value      = "%[4]s"
+}`, resourceName, zoneID, settingID, value)
+}
+
+func TestZoneSettingUpdateSkipsNonEditableSetting(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/settings/cache_level", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected non-editable setting not to be updated, got %s request", r.Method)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "cache_level", "value": "aggressive", "editable": false}
+		}`)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZoneSettingSchema(), map[string]interface{}{
+		"zone_id":    testAccCloudflareZoneID,
+		"setting_id": "cache_level",
+		"value":      "basic",
+	})
+	d.SetId(testAccCloudflareZoneID + "/cache_level")
+
+	diags := resourceCloudflareZoneSettingUpdate(context.Background(), d, client)
+	if diags.HasError() {
+		t.Fatalf("expected non-editable setting to be skipped without an error, got %v", diags)
+	}
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	expectedSummary := fmt.Sprintf("Zone setting \"cache_level\" is not editable for zone %q", testAccCloudflareZoneID)
+	if diags[0].Summary != expectedSummary {
+		t.Errorf("expected warning %q, got %q", expectedSummary, diags[0].Summary)
+	}
+
+	if value := d.Get("value").(string); value != "basic" {
+		t.Errorf("expected configured value to be kept, got %q", value)
+	}
+}
+
+func TestExpandZoneSettingValue(t *testing.T) {
+	testCases := map[string]struct {
+		settingID string
+		value     string
+		expected  interface{}
+		err       string
+	}{
+		"cache level is sent as a string": {
+			settingID: "cache_level",
+			value:     "aggressive",
+			expected:  "aggressive",
+		},
+		"cache level must be a known level": {
+			settingID: "cache_level",
+			value:     "everything",
+			err:       "expected cache_level to be one of [aggressive basic simplified], got everything",
+		},
+		"browser cache TTL is sent as a number": {
+			settingID: "browser_cache_ttl",
+			value:     "1800",
+			expected:  1800,
+		},
+		"browser cache TTL must be a number": {
+			settingID: "browser_cache_ttl",
+			value:     "2h",
+			err:       `value of zone setting "browser_cache_ttl" must be a number, got "2h"`,
+		},
+		"browser cache TTL must be an allowed duration": {
+			settingID: "browser_cache_ttl",
+			value:     "1000",
+			err:       "expected browser_cache_ttl to be one of",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := expandZoneSettingValue(tc.settingID, tc.value)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != tc.expected {
+				t.Errorf("expected %#v, got %#v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestFlattenZoneSettingValue(t *testing.T) {
+	testCases := map[string]struct {
+		value    interface{}
+		expected string
+	}{
+		"string":         {value: "simplified", expected: "simplified"},
+		"whole number":   {value: float64(31536000), expected: "31536000"},
+		"missing values": {value: nil, expected: ""},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := flattenZoneSettingValue(tc.value); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestZoneSettingAPORoundTrip(t *testing.T) {
+	mux := http.NewServeMux()
+	current := `{"enabled": false, "cf": false, "wordpress": false, "wp_plugin": false, "cache_by_device_type": false, "hostnames": []}`
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/settings/automatic_platform_optimization", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var body struct {
+				Value json.RawMessage `json:"value"`
+			}
+			if !decodeTestRequestBody(t, w, r, &body) {
+				return
+			}
+			current = string(body.Value)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "automatic_platform_optimization", "value": %s, "editable": true}
+		}`, current)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZoneSettingSchema(), map[string]interface{}{
+		"zone_id":    testAccCloudflareZoneID,
+		"setting_id": "automatic_platform_optimization",
+		"automatic_platform_optimization": []interface{}{map[string]interface{}{
+			"enabled":   true,
+			"cf":        true,
+			"wordpress": true,
+			"hostnames": []interface{}{"www.example.com", "example.com"},
+		}},
+	})
+
+	if diags := resourceCloudflareZoneSettingCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := `{"enabled":true,"cache_by_device_type":false,"cf":true,"wordpress":true,"wp_plugin":false,"hostnames":["example.com","www.example.com"]}`
+	if current != expected {
+		t.Errorf("expected the setting to be updated to %s, got %s", expected, current)
+	}
+
+	var initial zoneSettingAPOValue
+	if err := json.Unmarshal([]byte(d.Get("initial_value").(string)), &initial); err != nil {
+		t.Fatalf("expected initial_value to be JSON encoded, got %q: %s", d.Get("initial_value"), err)
+	}
+	if initial.Enabled {
+		t.Errorf("expected initial_value to be disabled, got %#v", initial)
+	}
+
+	if enabled := d.Get("automatic_platform_optimization.0.enabled").(bool); !enabled {
+		t.Error("expected automatic_platform_optimization to be enabled after read")
+	}
+	if hostnames := d.Get("automatic_platform_optimization.0.hostnames").(*schema.Set).Len(); hostnames != 2 {
+		t.Errorf("expected 2 hostnames after read, got %d", hostnames)
+	}
+
+	if diags := resourceCloudflareZoneSettingDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !strings.Contains(current, `"enabled":false`) || !strings.Contains(current, `"hostnames":[]`) {
+		t.Errorf("expected the setting to be restored to its initial value, got %s", current)
+	}
+}
+
+func TestValidateZoneHostnames(t *testing.T) {
+	testCases := map[string]struct {
+		hostnames []string
+		err       string
+	}{
+		"apex and subdomains": {
+			hostnames: []string{"example.com", "www.example.com", "Blog.Example.com."},
+		},
+		"another zone": {
+			hostnames: []string{"www.example.com", "example.net"},
+			err:       `hostname "example.net" doesn't belong to zone "example.com"`,
+		},
+		"zone name as a suffix of another domain": {
+			hostnames: []string{"notexample.com"},
+			err:       `hostname "notexample.com" doesn't belong to zone "example.com"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateZoneHostnames("example.com", tc.hostnames)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != tc.err {
+				t.Fatalf("expected error %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestZoneSettingSSLRecommenderRoundTrip(t *testing.T) {
+	mux := http.NewServeMux()
+	enabled := false
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/settings/ssl_recommender", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var body map[string]interface{}
+			if !decodeTestRequestBody(t, w, r, &body) {
+				return
+			}
+			if _, ok := body["value"]; ok {
+				t.Errorf("expected ssl_recommender to be updated using `enabled` rather than `value`, got %v", body)
+			}
+			enabled = body["enabled"].(bool)
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "ssl_recommender", "enabled": %t, "editable": true}
+		}`, enabled)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZoneSettingSchema(), map[string]interface{}{
+		"zone_id":         testAccCloudflareZoneID,
+		"setting_id":      "ssl_recommender",
+		"ssl_recommender": []interface{}{map[string]interface{}{"enabled": true}},
+	})
+
+	if diags := resourceCloudflareZoneSettingCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !enabled {
+		t.Error("expected the SSL/TLS recommender to be enabled")
+	}
+	if got := d.Get("initial_value").(string); got != `{"enabled":false}` {
+		t.Errorf("expected initial_value to be the disabled recommender, got %q", got)
+	}
+	if !d.Get("ssl_recommender.0.enabled").(bool) {
+		t.Error("expected ssl_recommender to be enabled after read")
+	}
+
+	if diags := resourceCloudflareZoneSettingDelete(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if enabled {
+		t.Error("expected the SSL/TLS recommender to be restored to disabled")
+	}
+}
+
+func TestZoneSettingValidateAPOConfiguration(t *testing.T) {
+	testCases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"APO without the block": {
+			config: map[string]interface{}{
+				"zone_id":    testAccCloudflareZoneID,
+				"setting_id": "automatic_platform_optimization",
+				"value":      "on",
+			},
+			err: `zone setting "automatic_platform_optimization" must be configured using the ` + "`automatic_platform_optimization`" + ` block`,
+		},
+		"APO block for another setting": {
+			config: map[string]interface{}{
+				"zone_id":                         testAccCloudflareZoneID,
+				"setting_id":                      "cache_level",
+				"value":                           "basic",
+				"automatic_platform_optimization": []interface{}{map[string]interface{}{"enabled": true}},
+			},
+			err: "`automatic_platform_optimization` can only be set for zone setting \"automatic_platform_optimization\"",
+		},
+		"value missing": {
+			config: map[string]interface{}{
+				"zone_id":    testAccCloudflareZoneID,
+				"setting_id": "cache_level",
+			},
+			err: "`value` must be set for zone setting \"cache_level\"",
+		},
+		"SSL recommender without the block": {
+			config: map[string]interface{}{
+				"zone_id":    testAccCloudflareZoneID,
+				"setting_id": "ssl_recommender",
+				"value":      "on",
+			},
+			err: `zone setting "ssl_recommender" must be configured using the ` + "`ssl_recommender`" + ` block`,
+		},
+		"SSL recommender block for another setting": {
+			config: map[string]interface{}{
+				"zone_id":         testAccCloudflareZoneID,
+				"setting_id":      "cache_level",
+				"value":           "basic",
+				"ssl_recommender": []interface{}{map[string]interface{}{"enabled": true}},
+			},
+			err: "`ssl_recommender` can only be set for zone setting \"ssl_recommender\"",
+		},
+		"SSL recommender": {
+			config: map[string]interface{}{
+				"zone_id":         testAccCloudflareZoneID,
+				"setting_id":      "ssl_recommender",
+				"ssl_recommender": []interface{}{map[string]interface{}{"enabled": true}},
+			},
+		},
+		"APO without hostnames": {
+			config: map[string]interface{}{
+				"zone_id":                         testAccCloudflareZoneID,
+				"setting_id":                      "automatic_platform_optimization",
+				"automatic_platform_optimization": []interface{}{map[string]interface{}{"enabled": false}},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := resourceCloudflareZoneSetting().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), nil)
+			if tc.err == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -150,11 +150,21 @@ func resourceCloudflareZoneSettingsOverrideRead(ctx context.Context, d *schema.R
 		newZoneSettings[0]["webp"] = d.Get("settings.0.webp").(string)
 	}
 
+	// settings that can't be edited were skipped during the update so keep
+	// the configured value until they become editable, rather than planning
+	// the same change on every run.
+	readOnlySettings := flattenReadOnlyZoneSettings(ctx, zoneSettings.Result)
+	for _, k := range readOnlySettings {
+		if value, ok := d.GetOk(fmt.Sprintf("settings.0.%s", k)); ok {
+			newZoneSettings[0][k] = value
+		}
+	}
+
 	if err := d.Set("settings", newZoneSettings); err != nil {
 		log.Printf("[WARN] Error setting settings for zone %q: %s", d.Id(), err)
 	}
 
-	if err := d.Set("readonly_settings", flattenReadOnlyZoneSettings(ctx, zoneSettings.Result)); err != nil {
+	if err := d.Set("readonly_settings", readOnlySettings); err != nil {
 		log.Printf("[WARN] Error setting readonly_settings for zone %q: %s", d.Id(), err)
 	}
 
@@ -261,8 +271,19 @@ func updateUniversalSSLSetting(ctx context.Context, zoneSettings []cloudflare.Zo
 func resourceCloudflareZoneSettingsOverrideUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
+	var diags diag.Diagnostics
 	if cfg, ok := d.GetOkExists("settings"); ok && cfg != nil && len(cfg.([]interface{})) > 0 {
 		readOnlySettings := expandInterfaceToStringList(d.Get("readonly_settings"))
+		for _, k := range readOnlySettings {
+			key := fmt.Sprintf("settings.0.%s", k)
+			if _, ok := d.GetOkExists(key); ok && d.HasChange(key) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Zone setting %q is not editable for zone %q", k, d.Id()),
+					Detail:   "The setting was not changed. It will be applied once it becomes editable, for example when the zone's plan allows it.",
+				})
+			}
+		}
 		zoneSettings, err := expandOverriddenZoneSettings(d, "settings", readOnlySettings)
 		if err != nil {
 			return diag.FromErr(err)
@@ -288,7 +309,7 @@ func resourceCloudflareZoneSettingsOverrideUpdate(ctx context.Context, d *schema
 		}
 	}
 
-	return resourceCloudflareZoneSettingsOverrideRead(ctx, d, meta)
+	return append(diags, resourceCloudflareZoneSettingsOverrideRead(ctx, d, meta)...)
 }
 
 func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, readOnlySettings []string) ([]cloudflare.ZoneSetting, error) {
@@ -323,8 +344,10 @@ func expandOverriddenZoneSettings(d *schema.ResourceData, settingsKey string, re
 }
 
 func expandZoneSetting(d *schema.ResourceData, keyFormatString, k string, settingValue interface{}, readOnlySettings []string) (interface{}, error) {
+	// settings which aren't editable are skipped rather than failing the
+	// apply, the update warns about them instead.
 	if contains(readOnlySettings, k) {
-		return nil, fmt.Errorf("invalid zone setting %q (value: %v) found - cannot be set as it is read only", k, settingValue)
+		return nil, nil
 	}
 
 	var zoneSettingValue interface{}
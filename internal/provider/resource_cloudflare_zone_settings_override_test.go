@@ -81,6 +81,64 @@ func TestAccCloudflareZoneSettingsOverride_RemoveAttributes(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareZoneSettingsOverride_HTTP3(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_settings_override." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareZoneSettingsOverrideConfigSingle(rnd, zoneID, "http3", "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "settings.0.http3", "on"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZoneSettingsOverride_Websockets(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_settings_override." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareZoneSettingsOverrideConfigSingle(rnd, zoneID, "websockets", "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "settings.0.websockets", "on"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandZoneSettingSkipsReadOnlySettings(t *testing.T) {
+	d := resourceCloudflareZoneSettingsOverride().TestResourceData()
+	readOnlySettings := []string{"http3", "always_online"}
+
+	for _, k := range readOnlySettings {
+		value, err := expandZoneSetting(d, "settings.0.%s", k, "on", readOnlySettings)
+		if err != nil {
+			t.Errorf("expected read only setting %q to be skipped without an error, got: %s", k, err)
+		}
+		if value != nil {
+			t.Errorf("expected read only setting %q to be skipped, got value %#v", k, value)
+		}
+	}
+
+	value, err := expandZoneSetting(d, "settings.0.%s", "websockets", "on", readOnlySettings)
+	if err != nil || value != "on" {
+		t.Errorf("expected editable setting to be expanded, got %#v (err: %v)", value, err)
+	}
+}
+
 func testAccCheckCloudflareZoneSettings(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -214,3 +272,13 @@ resource "cloudflare_zone_settings_override" "%[1]s" {
 	}
 }`, rnd, zoneID)
 }
+
+func testAccCheckCloudflareZoneSettingsOverrideConfigSingle(rnd, zoneID, setting, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_settings_override" "%[1]s" {
+	zone_id = "%[2]s"
+	settings {
+		%[3]s = "%[4]s"
+	}
+}`, rnd, zoneID, setting, value)
+}
@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccCloudflareZone_Basic(t *testing.T) {
@@ -30,6 +36,186 @@ func TestAccCloudflareZone_Basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareZone_TogglePaused(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone." + rnd
+	zoneName := fmt.Sprintf("%s.cfapi.net", rnd)
+	var zoneID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testZoneConfig(rnd, zoneName, "false", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "paused", "false"),
+					testAccCheckCloudflareZoneIDUnchanged(name, &zoneID),
+				),
+			},
+			{
+				Config: testZoneConfig(rnd, zoneName, "true", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "paused", "true"),
+					testAccCheckCloudflareZoneIDUnchanged(name, &zoneID),
+				),
+			},
+			{
+				Config: testZoneConfig(rnd, zoneName, "false", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "paused", "false"),
+					testAccCheckCloudflareZoneIDUnchanged(name, &zoneID),
+				),
+			},
+		},
+	})
+}
+
+func TestZonePausedUpdatesInPlace(t *testing.T) {
+	testCases := map[string]struct {
+		statePaused string
+		config      map[string]interface{}
+	}{
+		"pausing the zone": {
+			statePaused: "false",
+			config:      map[string]interface{}{"zone": "example.com", "paused": true},
+		},
+		"unpausing the zone by removing paused": {
+			statePaused: "true",
+			config:      map[string]interface{}{"zone": "example.com"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "023e105f4ecef8ad9ca31a8372d0c353",
+				Attributes: map[string]string{
+					"id":     "023e105f4ecef8ad9ca31a8372d0c353",
+					"zone":   "example.com",
+					"paused": tc.statePaused,
+					"type":   "full",
+					"plan":   planIDFree,
+				},
+			}
+
+			diff, err := resourceCloudflareZone().Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+			if err != nil {
+				t.Fatalf("unexpected error computing diff: %s", err)
+			}
+
+			if diff == nil || diff.Attributes["paused"] == nil {
+				t.Fatal("expected a diff for paused")
+			}
+
+			if diff.RequiresNew() {
+				t.Errorf("expected the zone to be updated in place, got a replacement: %#v", diff.Attributes)
+			}
+		})
+	}
+}
+
+func TestAccCloudflareZone_AccountChangeIsRejected(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone." + rnd
+	zoneName := fmt.Sprintf("%s.cfapi.net", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testZoneConfigWithAccount(rnd, zoneName, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+				),
+			},
+			{
+				Config:      testZoneConfigWithAccount(rnd, zoneName, "01a7362d577a6c3019a474fd6f485823"),
+				ExpectError: regexp.MustCompile(`moving a zone between accounts isn't supported by the API`),
+			},
+		},
+	})
+}
+
+func testZoneConfigWithAccount(resourceID, zoneName, accountID string) string {
+	return fmt.Sprintf(`
+				resource "cloudflare_zone" "%[1]s" {
+					zone = "%[2]s"
+					account_id = "%[3]s"
+				}`, resourceID, zoneName, accountID)
+}
+
+func TestZoneAccountChangeIsRejected(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "023e105f4ecef8ad9ca31a8372d0c353",
+		Attributes: map[string]string{
+			"id":         "023e105f4ecef8ad9ca31a8372d0c353",
+			"account_id": "f037e56e89293a057740de681ac9abbe",
+			"zone":       "example.com",
+			"paused":     "false",
+			"type":       "full",
+			"plan":       planIDFree,
+		},
+	}
+
+	testCases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"moving the zone to another account": {
+			config: map[string]interface{}{"zone": "example.com", "account_id": "01a7362d577a6c3019a474fd6f485823"},
+			err:    `zone "example.com" can't be moved from account "f037e56e89293a057740de681ac9abbe" to account "01a7362d577a6c3019a474fd6f485823"`,
+		},
+		"keeping the same account": {
+			config: map[string]interface{}{"zone": "example.com", "account_id": "f037e56e89293a057740de681ac9abbe"},
+		},
+		"not configuring the account": {
+			config: map[string]interface{}{"zone": "example.com"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diff, err := resourceCloudflareZone().Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error computing diff: %s", err)
+			}
+			if diff != nil && diff.RequiresNew() {
+				t.Errorf("expected the zone not to be replaced, got %#v", diff.Attributes)
+			}
+		})
+	}
+}
+
+func testAccCheckCloudflareZoneIDUnchanged(n string, zoneID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if *zoneID == "" {
+			*zoneID = rs.Primary.ID
+		} else if rs.Primary.ID != *zoneID {
+			return fmt.Errorf("expected zone %s to be updated in place, but it was replaced by %s", *zoneID, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
 func TestAccCloudflareZone_BasicWithJumpStartEnabled(t *testing.T) {
 	rnd := generateRandomResourceName()
 	name := "cloudflare_zone." + rnd
@@ -90,12 +276,262 @@ func TestAccCloudflareZone_PartialSetup(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "paused", "true"),
 					resource.TestCheckResourceAttr(name, "plan", planIDFree),
 					resource.TestCheckResourceAttr(name, "type", "partial"),
+					resource.TestCheckResourceAttrSet(name, "verification_key"),
+					resource.TestCheckResourceAttr(name, "verification_record.#", "1"),
+					resource.TestCheckResourceAttr(name, "verification_record.0.name", "cloudflare-verify.foo.net"),
+					resource.TestCheckResourceAttr(name, "verification_record.0.type", "TXT"),
+					resource.TestCheckResourceAttrPair(name, "verification_record.0.value", name, "verification_key"),
 				),
 			},
 		},
 	})
 }
 
+func TestZoneReadSetsVerificationRecordForPartialZone(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {
+			"id": "%s",
+			"name": "example.com",
+			"status": "pending",
+			"type": "partial",
+			"paused": false,
+			"verification_key": "484802398-5c1dba66-3a5d-4b1b-9bcb-ef2fe2ec8bdc",
+			"plan": {"legacy_id": "free"}
+		}}`, testAccCloudflareZoneID)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := resourceCloudflareZone().TestResourceData()
+	d.SetId(testAccCloudflareZoneID)
+
+	if diags := resourceCloudflareZoneRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error reading zone: %v", diags)
+	}
+
+	expected := map[string]string{
+		"verification_key":            "484802398-5c1dba66-3a5d-4b1b-9bcb-ef2fe2ec8bdc",
+		"verification_record.0.name":  "cloudflare-verify.example.com",
+		"verification_record.0.type":  "TXT",
+		"verification_record.0.value": "484802398-5c1dba66-3a5d-4b1b-9bcb-ef2fe2ec8bdc",
+	}
+	for key, value := range expected {
+		if got := d.Get(key).(string); got != value {
+			t.Errorf("expected %s to be %q, got %q", key, value, got)
+		}
+	}
+}
+
+func TestZonePlanRepresentationsDoNotDiff(t *testing.T) {
+	for _, statePlan := range []string{"pro", "PRO", "CF_PRO_20_20"} {
+		t.Run(statePlan, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "023e105f4ecef8ad9ca31a8372d0c353",
+				Attributes: map[string]string{
+					"id":     "023e105f4ecef8ad9ca31a8372d0c353",
+					"zone":   "example.com",
+					"paused": "false",
+					"type":   "full",
+					"plan":   statePlan,
+				},
+			}
+
+			diff, err := resourceCloudflareZone().Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"zone": "example.com",
+				"plan": planIDPro,
+			}), nil)
+			if err != nil {
+				t.Fatalf("unexpected error computing diff: %s", err)
+			}
+
+			if diff != nil && diff.Attributes["plan"] != nil {
+				t.Errorf("expected no diff for plan %q, got %#v", statePlan, diff.Attributes["plan"])
+			}
+		})
+	}
+}
+
+func TestZoneReadUsesCanonicalPlanID(t *testing.T) {
+	testCases := map[string]string{
+		"legacy id":         `{"id": "94f3b7b768b0458b56d2cac4fe5ec0f9", "legacy_id": "pro", "name": "Pro Website"}`,
+		"uppercase id":      `{"id": "94f3b7b768b0458b56d2cac4fe5ec0f9", "legacy_id": "PRO", "name": "Pro Website"}`,
+		"subscription name": `{"id": "94f3b7b768b0458b56d2cac4fe5ec0f9", "name": "CF_PRO_20_20"}`,
+	}
+
+	for name, plan := range testCases {
+		t.Run(name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/zones/"+testAccCloudflareZoneID, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("content-type", "application/json")
+				fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {
+					"id": "%s",
+					"name": "example.com",
+					"status": "active",
+					"type": "full",
+					"plan": %s
+				}}`, testAccCloudflareZoneID, plan)
+			})
+
+			client := newTestAPIClient(t, mux)
+
+			d := resourceCloudflareZone().TestResourceData()
+			d.SetId(testAccCloudflareZoneID)
+
+			if diags := resourceCloudflareZoneRead(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error reading zone: %v", diags)
+			}
+
+			if got := d.Get("plan").(string); got != planIDPro {
+				t.Errorf("expected plan to be %q, got %q", planIDPro, got)
+			}
+		})
+	}
+}
+
+func TestZoneCreateDoesNotWaitForActivation(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "example.com", "type": "partial", "status": "pending"}}`, testAccCloudflareZoneID)
+	})
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "example.com", "type": "partial", "status": "pending", "plan": {"legacy_id": "free"}}}`, testAccCloudflareZoneID)
+	})
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/activation_check", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected activation check while creating the zone")
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	d := resourceCloudflareZone().TestResourceData()
+	d.Set("account_id", testAccCloudflareAccountID)
+	d.Set("zone", "example.com")
+	d.Set("type", "partial")
+	d.Set("wait_for_activation", true)
+
+	if diags := resourceCloudflareZoneCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error creating zone: %v", diags)
+	}
+
+	if got := d.Get("status").(string); got != "pending" {
+		t.Errorf("expected the zone to be left pending after create, got %q", got)
+	}
+}
+
+func TestZoneWaitsForActivationWithUnchangedConfig(t *testing.T) {
+	var activationChecks, polls int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/activation_check", func(w http.ResponseWriter, r *http.Request) {
+		activationChecks++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s"}}`, testAccCloudflareZoneID)
+	})
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID, func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "pending"
+		if polls > 2 {
+			status = "active"
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "example.com", "type": "partial", "status": "%s", "plan": {"legacy_id": "free"}, "account": {"id": "%s"}}}`, testAccCloudflareZoneID, status, testAccCloudflareAccountID)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	state := &terraform.InstanceState{
+		ID: testAccCloudflareZoneID,
+		Attributes: map[string]string{
+			"id":                  testAccCloudflareZoneID,
+			"account_id":          testAccCloudflareAccountID,
+			"zone":                "example.com",
+			"type":                "partial",
+			"plan":                "free",
+			"paused":              "false",
+			"jump_start":          "false",
+			"status":              "pending",
+			"wait_for_activation": "true",
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id":          testAccCloudflareAccountID,
+		"zone":                "example.com",
+		"type":                "partial",
+		"wait_for_activation": true,
+	})
+
+	resource := resourceCloudflareZone()
+	diff, err := resource.Diff(context.Background(), state, config, client)
+	if err != nil {
+		t.Fatalf("unexpected error planning: %s", err)
+	}
+
+	if diff == nil || diff.Attributes["status"] == nil || !diff.Attributes["status"].NewComputed {
+		t.Fatalf("expected an update of status to be planned for the pending zone, got %+v", diff)
+	}
+
+	newState, diags := resource.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("unexpected error applying: %v", diags)
+	}
+
+	if activationChecks != 1 {
+		t.Errorf("expected 1 activation check, got %d", activationChecks)
+	}
+
+	if newState.Attributes["status"] != "active" {
+		t.Errorf("expected the zone to be active after the apply, got %q", newState.Attributes["status"])
+	}
+
+	// Once active, nothing is planned for the unchanged configuration.
+	diff, err = resource.Diff(context.Background(), newState, config, client)
+	if err != nil {
+		t.Fatalf("unexpected error planning: %s", err)
+	}
+
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no changes once the zone is active, got %+v", diff)
+	}
+}
+
+func TestWaitForZoneActivation(t *testing.T) {
+	var activationChecks, polls int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID+"/activation_check", func(w http.ResponseWriter, r *http.Request) {
+		activationChecks++
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s"}}`, testAccCloudflareZoneID)
+	})
+	mux.HandleFunc("/zones/"+testAccCloudflareZoneID, func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "pending"
+		if polls > 1 {
+			status = "active"
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s", "name": "example.com", "type": "partial", "status": "%s"}}`, testAccCloudflareZoneID, status)
+	})
+
+	client := newTestAPIClient(t, mux)
+
+	if err := waitForZoneActivation(context.Background(), client, testAccCloudflareZoneID, time.Minute); err != nil {
+		t.Fatalf("unexpected error waiting for zone activation: %s", err)
+	}
+
+	if activationChecks != 1 {
+		t.Errorf("expected 1 activation check, got %d", activationChecks)
+	}
+
+	if polls != 2 {
+		t.Errorf("expected the zone to be polled until active after 2 requests, got %d", polls)
+	}
+}
+
 func TestAccCloudflareZone_FullSetup(t *testing.T) {
 	rnd := generateRandomResourceName()
 	name := "cloudflare_zone." + rnd
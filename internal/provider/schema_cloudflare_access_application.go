@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -26,6 +27,13 @@ func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 			Computed:      true,
 			ConflictsWith: []string{"account_id"},
 		},
+		"tags": {
+			Type:          schema.TypeSet,
+			Optional:      true,
+			Elem:          &schema.Schema{Type: schema.TypeString},
+			ConflictsWith: []string{"zone_id"},
+			Description:   "The names of the Access Tags to attach to the application. Tags must already exist in the account.",
+		},
 		"aud": {
 			Type:        schema.TypeString,
 			Computed:    true,
@@ -38,15 +46,58 @@ func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 		},
 		"domain": {
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The complete URL of the asset you wish to put Cloudflare Access in front of. Can include subdomains or paths. Or both.",
+			Optional:    true,
+			Description: "The complete URL of the asset you wish to put Cloudflare Access in front of. Can include subdomains or paths. Or both. Required unless `type` is `infrastructure`.",
 		},
 		"type": {
 			Type:         schema.TypeString,
 			Optional:     true,
 			Default:      "self_hosted",
-			ValidateFunc: validation.StringInSlice([]string{"self_hosted", "ssh", "vnc", "file"}, false),
-			Description:  fmt.Sprintf("The application type. %s", renderAvailableDocumentationValuesStringSlice([]string{"self_hosted", "ssh", "vnc", "file"})),
+			ValidateFunc: validation.StringInSlice([]string{"self_hosted", "ssh", "vnc", "file", "infrastructure"}, false),
+			Description:  fmt.Sprintf("The application type. %s", renderAvailableDocumentationValuesStringSlice([]string{"self_hosted", "ssh", "vnc", "file", "infrastructure"})),
+		},
+		"target_criteria": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The infrastructure targets the application grants access to. Required when `type` is `infrastructure`.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"port": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(1, 65535),
+						Description:  "The port that the targets use for the chosen communication protocol.",
+					},
+					"protocol": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"SSH", "RDP"}, false),
+						Description:  fmt.Sprintf("The communication protocol your application secures. %s", renderAvailableDocumentationValuesStringSlice([]string{"SSH", "RDP"})),
+					},
+					"target_attributes": {
+						Type:        schema.TypeList,
+						Required:    true,
+						MinItems:    1,
+						Description: "Contains a map of target attribute keys to target attribute values.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "The key of the attribute.",
+								},
+								"values": {
+									Type:        schema.TypeList,
+									Required:    true,
+									MinItems:    1,
+									Elem:        &schema.Schema{Type: schema.TypeString},
+									Description: "The values of the attribute.",
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 		"session_duration": {
 			Type:     schema.TypeString,
@@ -186,6 +237,25 @@ func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 			Default:     false,
 			Description: "Option to return a 401 status code in service authentication rules on failed requests.",
 		},
+		"options_preflight_bypass": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"cors_headers"},
+			Description:   "Allows `OPTIONS` preflight requests to go to the origin as unauthenticated requests.",
+		},
+		"path_cookie_attribute": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Option to scope the authorization cookie to the path of the application instead of the whole hostname.",
+		},
+		"allow_authenticate_via_warp": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Option to allow users with a WARP session to authenticate to the application without logging in again. Defaults to the setting of the organization when not set.",
+		},
 	}
 }
 
@@ -261,3 +331,68 @@ func convertCORSStructToSchema(d *schema.ResourceData, headers *cloudflare.Acces
 
 	return []interface{}{m}
 }
+
+func convertTargetCriteriaSchemaToStruct(d *schema.ResourceData) []accessApplicationTargetCriteria {
+	var targetCriteria []accessApplicationTargetCriteria
+
+	for _, item := range d.Get("target_criteria").([]interface{}) {
+		criteria := item.(map[string]interface{})
+
+		attributes := make(map[string][]string)
+		for _, attribute := range criteria["target_attributes"].([]interface{}) {
+			attr := attribute.(map[string]interface{})
+			attributes[attr["name"].(string)] = expandInterfaceToStringList(attr["values"])
+		}
+
+		targetCriteria = append(targetCriteria, accessApplicationTargetCriteria{
+			Port:             criteria["port"].(int),
+			Protocol:         criteria["protocol"].(string),
+			TargetAttributes: attributes,
+		})
+	}
+
+	return targetCriteria
+}
+
+// convertTargetCriteriaStructToSchema flattens the target criteria returned by
+// the API. Target attributes are a map in the API so they are kept in the
+// order they were configured in, with any unknown attributes sorted by name
+// at the end.
+func convertTargetCriteriaStructToSchema(d *schema.ResourceData, targetCriteria []accessApplicationTargetCriteria) []interface{} {
+	var criteria []interface{}
+
+	for i, c := range targetCriteria {
+		var names []string
+		for _, attribute := range d.Get(fmt.Sprintf("target_criteria.%d.target_attributes", i)).([]interface{}) {
+			name := attribute.(map[string]interface{})["name"].(string)
+			if _, ok := c.TargetAttributes[name]; ok {
+				names = append(names, name)
+			}
+		}
+
+		var unknownNames []string
+		for name := range c.TargetAttributes {
+			if !contains(names, name) {
+				unknownNames = append(unknownNames, name)
+			}
+		}
+		sort.Strings(unknownNames)
+		names = append(names, unknownNames...)
+
+		var attributes []interface{}
+		for _, name := range names {
+			attributes = append(attributes, map[string]interface{}{
+				"name":   name,
+				"values": c.TargetAttributes[name],
+			})
+		}
+
+		criteria = append(criteria, map[string]interface{}{
+			"port":              c.Port,
+			"protocol":          c.Protocol,
+			"target_attributes": attributes,
+		})
+	}
+
+	return criteria
+}
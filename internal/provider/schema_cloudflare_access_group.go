@@ -33,9 +33,10 @@ func resourceCloudflareAccessGroupSchema() map[string]*schema.Schema {
 			Elem:     AccessGroupOptionSchemaElement,
 		},
 		"include": {
-			Type:     schema.TypeList,
-			Required: true,
-			Elem:     AccessGroupOptionSchemaElement,
+			Type:        schema.TypeList,
+			Required:    true,
+			Elem:        AccessGroupOptionSchemaElement,
+			Description: "Conditions that determine who is a member of the group. Must contain at least one condition.",
 		},
 	}
 }
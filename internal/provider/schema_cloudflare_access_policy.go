@@ -82,6 +82,42 @@ func resourceCloudflareAccessPolicySchema() map[string]*schema.Schema {
 			Optional: true,
 			Elem:     AccessPolicyApprovalGroupElement,
 		},
+		"connection_rules": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "The rules that define how users may connect to the targets secured by an infrastructure Access Application.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ssh": {
+						Type:        schema.TypeList,
+						Required:    true,
+						MaxItems:    1,
+						Description: "The SSH-specific rules that define how users may connect to the targets secured by the application.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"usernames": {
+									Type:     schema.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &schema.Schema{
+										Type:         schema.TypeString,
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									Description: "Contains the Unix usernames that may be used when connecting over SSH.",
+								},
+								"allow_email_alias": {
+									Type:        schema.TypeBool,
+									Optional:    true,
+									Default:     false,
+									Description: "Allows connecting to Unix usernames that match the authenticating email prefix.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -1,6 +1,11 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 func resourceCloudflareAccessServiceTokenSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -34,11 +39,19 @@ func resourceCloudflareAccessServiceTokenSchema() map[string]*schema.Schema {
 			ForceNew:    true,
 			Description: "A secret for interacting with Access protocols.",
 		},
+		"duration": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validateAccessServiceTokenDuration,
+			Description:  "Length of time the service token is valid for, such as `8760h` for one year, or `forever` for a token that never expires. Defaults to `8760h`.",
+		},
 		"expires_at": {
 			Type:        schema.TypeString,
 			Computed:    true,
 			ForceNew:    true,
-			Description: "Date when the token expires",
+			Description: "Date when the token expires. Empty for tokens with a `duration` of `forever`.",
 		},
 		"min_days_for_renewal": {
 			Type:        schema.TypeInt,
@@ -48,3 +61,19 @@ func resourceCloudflareAccessServiceTokenSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+// validateAccessServiceTokenDuration accepts `forever` or a positive duration
+// such as `8760h` or `2h45m`.
+func validateAccessServiceTokenDuration(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if v == "forever" {
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil || duration <= 0 {
+		errs = append(errs, fmt.Errorf(`%q must be "forever" or a positive duration using "ns", "us" (or "µs"), "ms", "s", "m", or "h" as units, got %q`, key, v))
+	}
+
+	return
+}
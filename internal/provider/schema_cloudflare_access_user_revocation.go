@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareAccessUserRevocationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"email": {
+			Description: "The email address of the user whose Access sessions should be revoked.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"revoke_seats": {
+			Description: "Whether to also remove the Access and Gateway seats of the user.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"revoke_trigger": {
+			Description: "An arbitrary value which revokes the sessions of the user again whenever it changes.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"access_seat": {
+			Description: "Whether the user currently occupies an Access seat.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"gateway_seat": {
+			Description: "Whether the user currently occupies a Gateway seat.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+}
@@ -1,13 +1,21 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
 
 func resourceCloudflareAccountMemberSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"email_address": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The email address of the user who you wish to manage. Following creation, this field becomes read only via the API and cannot be updated.",
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.EqualFold(old, new)
+			},
+			Description: "The email address of the user who you wish to manage. Following creation, this field becomes read only via the API and cannot be updated. Changing it removes the existing membership before the new email address is invited.",
 		},
 
 		"role_ids": {
@@ -41,6 +41,12 @@ func resourceCloudflareFilterSchema() map[string]*schema.Schema {
 			},
 			Description: "A note that you can use to describe the purpose of the filter.",
 		},
+		"validate_list_references": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to check during plan that every custom list referenced as `$list_name` in the expression exists in the account. Lists created in the same apply are reported as missing. Defaults to `false`.",
+		},
 		"ref": {
 			Type:         schema.TypeString,
 			Optional:     true,
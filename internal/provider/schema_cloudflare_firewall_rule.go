@@ -59,5 +59,15 @@ func resourceCloudflareFirewallRuleSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: fmt.Sprintf("List of products to bypass for a request when the bypass action is used. %s", renderAvailableDocumentationValuesStringSlice([]string{"zoneLockdown", "uaBlock", "bic", "hot", "securityLevel", "rateLimit", "waf"})),
 		},
+		"created_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The RFC3339 timestamp of when the rule was created.",
+		},
+		"modified_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The RFC3339 timestamp of when the rule was last modified.",
+		},
 	}
 }
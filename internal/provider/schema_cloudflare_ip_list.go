@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -33,6 +35,7 @@ func resourceCloudflareIPListSchema() map[string]*schema.Schema {
 			Type:     schema.TypeSet,
 			Optional: true,
 			Elem:     ipListItemElem,
+			Set:      ipListItemHash,
 		},
 	}
 }
@@ -44,8 +47,19 @@ var ipListItemElem = &schema.Resource{
 			Required: true,
 		},
 		"comment": {
-			Type:     schema.TypeString,
-			Optional: true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppressListItemCommentDiff,
 		},
 	},
 }
+
+// ipListItemHash hashes IP list items on their value and normalised comment so
+// that whitespace trimmed from comments by the API does not produce a new set
+// element.
+func ipListItemHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	comment, _ := m["comment"].(string)
+
+	return hashCodeString(fmt.Sprintf("%s-%s", m["value"], strings.TrimSpace(comment)))
+}
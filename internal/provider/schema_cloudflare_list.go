@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -29,7 +31,7 @@ func resourceCloudflareListSchema() map[string]*schema.Schema {
 		"kind": {
 			Description:  "The type of items the list will contain.",
 			Type:         schema.TypeString,
-			ValidateFunc: validation.StringInSlice([]string{"ip", "redirect"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"ip", "redirect", "hostname"}, false),
 			Required:     true,
 		},
 		"item": {
@@ -53,6 +55,26 @@ var listItemElem = &schema.Resource{
 						Type:     schema.TypeString,
 						Optional: true,
 					},
+					"hostname": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"url_hostname": {
+									Description:  "The hostname to match. Prefix it with `*.` to match all of its subdomains.",
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validateListItemHostname,
+								},
+								"exclude_exploded_hostnames": {
+									Description: "Whether a wildcard hostname only matches its subdomains rather than also matching the hostname itself.",
+									Type:        schema.TypeBool,
+									Optional:    true,
+								},
+							},
+						},
+					},
 					"redirect": {
 						Type:     schema.TypeList,
 						Optional: true,
@@ -100,9 +122,34 @@ var listItemElem = &schema.Resource{
 			},
 		},
 		"comment": {
-			Description: "An optional comment for the item.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:      "An optional comment for the item.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			DiffSuppressFunc: suppressListItemCommentDiff,
 		},
 	},
 }
+
+var listItemHostnamePattern = regexp.MustCompile(`^(\*\.)?([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// validateListItemHostname checks that the value of a hostname list item is a
+// bare, lowercase hostname which may start with a `*.` wildcard.
+func validateListItemHostname(v interface{}, k string) (warnings []string, errors []error) {
+	hostname := v.(string)
+
+	if strings.Contains(hostname, "://") || strings.ContainsAny(hostname, "/:?") {
+		errors = append(errors, fmt.Errorf("%q must be a hostname without a scheme, port or path, got %q", k, hostname))
+		return
+	}
+	if !listItemHostnamePattern.MatchString(hostname) {
+		errors = append(errors, fmt.Errorf("%q must be a lowercase hostname, optionally starting with \"*.\" to match its subdomains, got %q", k, hostname))
+	}
+
+	return
+}
+
+// suppressListItemCommentDiff ignores differences in list item comments that
+// only come from the API trimming surrounding whitespace.
+func suppressListItemCommentDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
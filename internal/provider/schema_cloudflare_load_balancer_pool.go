@@ -67,8 +67,31 @@ func resourceCloudflareLoadBalancerPoolSchema() map[string]*schema.Schema {
 		},
 
 		"notification_email": {
-			Type:     schema.TypeString,
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateEmailList,
+		},
+
+		"notification_filter": {
+			Type:     schema.TypeList,
 			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"origin": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem:     notificationFilterElem,
+					},
+					"pool": {
+						Type:     schema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem:     notificationFilterElem,
+					},
+				},
+			},
 		},
 
 		"load_shedding": {
@@ -124,6 +147,11 @@ var originsElem = &schema.Resource{
 			Default:  true,
 		},
 
+		"disabled_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+
 		"header": {
 			Type:     schema.TypeSet,
 			Optional: true,
@@ -147,6 +175,21 @@ var originsElem = &schema.Resource{
 	},
 }
 
+var notificationFilterElem = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"disable": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"healthy": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	},
+}
+
 var loadShedElem = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"default_percent": {
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var magicTransitSiteACLProtocols = []string{"tcp", "udp", "icmp"}
+
+func resourceCloudflareMagicTransitSiteACLSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"site_id": {
+			Description: "The Magic WAN site the ACL belongs to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Description: "The name of the ACL.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"description": {
+			Description: "Description of the ACL.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"lan_1": {
+			Description: "The first LAN the ACL applies to.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem:        magicTransitSiteACLLANSchemaElement,
+		},
+		"lan_2": {
+			Description: "The second LAN the ACL applies to.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem:        magicTransitSiteACLLANSchemaElement,
+		},
+		"protocols": {
+			Description: fmt.Sprintf("The protocols the ACL allows traffic for. All protocols are allowed when empty. %s", renderAvailableDocumentationValuesStringSlice(magicTransitSiteACLProtocols)),
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(magicTransitSiteACLProtocols, false),
+			},
+		},
+		"forward_locally": {
+			Description: "Whether traffic matching the ACL is forwarded directly between the LANs on the site rather than through Cloudflare.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+// magicTransitSiteACLLANSchemaElement is used by `lan_1` and `lan_2` to
+// describe one side of a site ACL.
+var magicTransitSiteACLLANSchemaElement = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"lan_id": {
+			Description: "The identifier of the LAN.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"lan_name": {
+			Description: "The name of the LAN.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"subnets": {
+			Description: "Subnets of the LAN the ACL applies to. The whole LAN is matched when empty.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+		"ports": {
+			Description: "Ports of the LAN the ACL applies to. Only valid when `protocols` is empty or includes `tcp` or `udp`. All ports are matched when empty.",
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IsPortNumber,
+			},
+		},
+	},
+}
@@ -34,7 +34,7 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 			Type:         schema.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR"}, false),
+			ValidateFunc: validation.StringInSlice([]string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "HTTPS", "NAPTR", "SMIMEA", "SSHFP", "SVCB", "TLSA", "URI", "PTR"}, false),
 		},
 
 		"value": {
@@ -74,6 +74,9 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 					"certificate": {
 						Type:     schema.TypeString,
 						Optional: true,
+						DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+							return strings.EqualFold(old, new)
+						},
 					},
 					"type": {
 						Type:     schema.TypeInt,
@@ -96,7 +99,7 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 
-					// SRV record properties
+					// SRV, HTTPS and SVCB record properties
 					"proto": {
 						Type:     schema.TypeString,
 						Optional: true,
@@ -106,8 +109,9 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 					"priority": {
-						Type:     schema.TypeInt,
-						Optional: true,
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
 					},
 					"port": {
 						Type:     schema.TypeInt,
@@ -224,9 +228,11 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 						Optional: true,
 					},
 
+					// CAA, HTTPS and SVCB record properties
 					"value": {
-						Type:     schema.TypeString,
-						Optional: true,
+						Type:             schema.TypeString,
+						Optional:         true,
+						DiffSuppressFunc: suppressEquivalentSVCBParams,
 					},
 				},
 			},
@@ -241,6 +247,8 @@ func resourceCloudflareRecordSchema() map[string]*schema.Schema {
 		"priority": {
 			Type:             schema.TypeInt,
 			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.IntBetween(0, 65535),
 			DiffSuppressFunc: suppressPriority,
 		},
 
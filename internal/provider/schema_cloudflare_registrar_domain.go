@@ -0,0 +1,46 @@
+package provider
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+func resourceCloudflareRegistrarDomainSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"domain": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"auto_renew": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"locked": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"privacy": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"name_servers": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"expires_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
@@ -42,14 +42,20 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 		"phase": {
 			Type:         schema.TypeString,
 			Required:     true,
-			ValidateFunc: validation.StringInSlice(cloudflare.RulesetPhaseValues(), false),
-			Description:  fmt.Sprintf("Point in the request/response lifecycle where the ruleset will be created. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetPhaseValues())),
+			ValidateFunc: validation.StringInSlice(rulesetPhaseValues(), false),
+			Description:  fmt.Sprintf("Point in the request/response lifecycle where the ruleset will be created. %s", renderAvailableDocumentationValuesStringSlice(rulesetPhaseValues())),
 		},
 		"shareable_entitlement_name": {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Description: "Name of entitlement that is shareable between entities.",
 		},
+		"validate_list_references": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to check during plan that every custom list referenced as `$list_name` in the rule expressions exists in the account. Lists created in the same apply are reported as missing. Defaults to `false`.",
+		},
 		"rules": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -68,8 +74,9 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 					},
 					"ref": {
 						Type:        schema.TypeString,
+						Optional:    true,
 						Computed:    true,
-						Description: "Rule reference.",
+						Description: "Rule reference. A user defined identifier for the rule that remains stable when rules are reordered. Rules keep the order returned by the API, so reordering them outside of Terraform shows as a change.",
 					},
 					"enabled": {
 						Type:        schema.TypeBool,
@@ -79,8 +86,8 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 					"action": {
 						Type:         schema.TypeString,
 						Optional:     true,
-						ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-						Description:  fmt.Sprintf("Action to perform in the ruleset rule. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionValues())),
+						ValidateFunc: validation.StringInSlice(rulesetRuleActionValues(), false),
+						Description:  fmt.Sprintf("Action to perform in the ruleset rule. %s", renderAvailableDocumentationValuesStringSlice(rulesetRuleActionValues())),
 					},
 					"expression": {
 						Description: "Criteria for an HTTP request to trigger the ruleset rule action. Uses the Firewall Rules expression language based on Wireshark display filters. Refer to the [Firewall Rules language](https://developers.cloudflare.com/firewall/cf-firewall-language) documentation for all available fields, operators, and functions",
@@ -115,7 +122,7 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 								"phases": {
 									Type:        schema.TypeSet,
 									Optional:    true,
-									Description: fmt.Sprintf("Point in the request/response lifecycle where the ruleset will be created. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetPhaseValues())),
+									Description: fmt.Sprintf("Point in the request/response lifecycle where the ruleset will be created. %s", renderAvailableDocumentationValuesStringSlice(rulesetPhaseValues())),
 									Elem: &schema.Schema{
 										Type: schema.TypeString,
 									},
@@ -177,7 +184,7 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 								"headers": {
 									Type:        schema.TypeList,
 									Optional:    true,
-									Description: "List of HTTP header modifications to perform in the ruleset rule.",
+									Description: "List of HTTP header modifications to perform in the ruleset rule. Headers are kept in the order they are configured.",
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"name": {
@@ -257,8 +264,8 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 											"action": {
 												Type:         schema.TypeString,
 												Optional:     true,
-												ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-												Description:  fmt.Sprintf("Action to perform in the rule-level override. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionValues())),
+												ValidateFunc: validation.StringInSlice(rulesetRuleActionValues(), false),
+												Description:  fmt.Sprintf("Action to perform in the rule-level override. %s", renderAvailableDocumentationValuesStringSlice(rulesetRuleActionValues())),
 											},
 											"categories": {
 												Type:        schema.TypeList,
@@ -269,13 +276,13 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 														"category": {
 															Type:        schema.TypeString,
 															Optional:    true,
-															Description: "Tag name to apply the ruleset rule override to.",
+															Description: "Tag name to apply the ruleset rule override to. For example, the OWASP Core Ruleset groups its rules by paranoia level using the `paranoia-level-1` to `paranoia-level-4` tags.",
 														},
 														"action": {
 															Type:         schema.TypeString,
 															Optional:     true,
-															ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-															Description:  fmt.Sprintf("Action to perform in the tag-level override. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionValues())),
+															ValidateFunc: validation.StringInSlice(rulesetRuleActionValues(), false),
+															Description:  fmt.Sprintf("Action to perform in the tag-level override. %s", renderAvailableDocumentationValuesStringSlice(rulesetRuleActionValues())),
 														},
 														"enabled": {
 															Type:        schema.TypeBool,
@@ -307,8 +314,8 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 														"action": {
 															Type:         schema.TypeString,
 															Optional:     true,
-															ValidateFunc: validation.StringInSlice(cloudflare.RulesetRuleActionValues(), false),
-															Description:  fmt.Sprintf("Action to perform in the rule-level override. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionValues())),
+															ValidateFunc: validation.StringInSlice(rulesetRuleActionValues(), false),
+															Description:  fmt.Sprintf("Action to perform in the rule-level override. %s", renderAvailableDocumentationValuesStringSlice(rulesetRuleActionValues())),
 														},
 														"enabled": {
 															Type:        schema.TypeBool,
@@ -324,9 +331,10 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 															Description:  fmt.Sprintf("Defines if the current rule-level override enables or disables the rule. %s", renderAvailableDocumentationValuesStringSlice([]string{"enabled", "disabled"})),
 														},
 														"score_threshold": {
-															Type:        schema.TypeInt,
-															Optional:    true,
-															Description: "Anomaly score threshold to apply in the ruleset rule override. Only applicable to modsecurity-based rulesets.",
+															Type:         schema.TypeInt,
+															Optional:     true,
+															ValidateFunc: validation.IntAtLeast(1),
+															Description:  "Anomaly score threshold to apply in the ruleset rule override. Only applicable to modsecurity-based rulesets, such as the OWASP Core Ruleset, where requests with a score greater than or equal to the threshold trigger the rule. Must be a positive integer.",
 														},
 														"sensitivity_level": {
 															Type:        schema.TypeString,
@@ -569,7 +577,7 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 																	"include": {
 																		Type:        schema.TypeList,
 																		Optional:    true,
-																		Description: "List of query string parameters to include in the custom key. Conflicts with \"exclude\".",
+																		Description: "List of query string parameters to include in the custom key, or `[\"*\"]` to include all of them. Conflicts with \"exclude\".",
 																		Elem: &schema.Schema{
 																			Type: schema.TypeString,
 																		},
@@ -577,7 +585,7 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 																	"exclude": {
 																		Type:        schema.TypeList,
 																		Optional:    true,
-																		Description: "List of query string parameters to exclude from the custom key. Conflicts with \"include\".",
+																		Description: "List of query string parameters to exclude from the custom key, or `[\"*\"]` to exclude all of them. Conflicts with \"include\".",
 																		Elem: &schema.Schema{
 																			Type: schema.TypeString,
 																		},
@@ -677,7 +685,7 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 																	"resolved": {
 																		Type:        schema.TypeBool,
 																		Optional:    true,
-																		Description: "Resolve hostname to IP address",
+																		Description: "Use the resolved host in the custom key instead of the original `Host` header",
 																	},
 																},
 															},
@@ -713,6 +721,39 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 										},
 									},
 								},
+								"algorithms": {
+									Type:        schema.TypeList,
+									Optional:    true,
+									Description: "Compression algorithms to use in order of preference for the `compress_response` action.",
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"name": {
+												Type:         schema.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringInSlice(rulesetCompressionAlgorithms, false),
+												Description:  fmt.Sprintf("Name of the compression algorithm to use. %s", renderAvailableDocumentationValuesStringSlice(rulesetCompressionAlgorithms)),
+											},
+										},
+									},
+								},
+								"content": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringLenBetween(1, 10240),
+									Description:  "Body of the error response served by the `serve_error` action.",
+								},
+								"content_type": {
+									Type:         schema.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringInSlice(rulesetServeErrorContentTypes, false),
+									Description:  fmt.Sprintf("Content type of the error response served by the `serve_error` action. %s", renderAvailableDocumentationValuesStringSlice(rulesetServeErrorContentTypes)),
+								},
+								"status_code": {
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(400, 999),
+									Description:  "HTTP status code of the error response served by the `serve_error` action. Must be between 400 and 999, defaults to the status code of the original error.",
+								},
 							},
 						},
 					},
@@ -726,25 +767,39 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 								"characteristics": {
 									Type:        schema.TypeSet,
 									Optional:    true,
-									Description: "List of parameters that define how Cloudflare tracks the request rate for this rule.",
+									Description: "List of parameters that define how Cloudflare tracks the request rate for this rule. Must contain at least one characteristic.",
 									Elem: &schema.Schema{
 										Type: schema.TypeString,
 									},
 								},
 								"period": {
-									Type:        schema.TypeInt,
-									Optional:    true,
-									Description: "The period of time to consider (in seconds) when evaluating the request rate.",
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(10, 86400),
+									Description:  "The period of time to consider (in seconds) when evaluating the request rate.",
 								},
 								"requests_per_period": {
-									Type:        schema.TypeInt,
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+									Description:  "The number of requests over the period of time that will trigger the Rate Limiting rule. Conflicts with `score_per_period`, one of the two must be set.",
+								},
+								"score_per_period": {
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+									Description:  "The maximum aggregate score over the period of time that will trigger the Rate Limiting rule. Conflicts with `requests_per_period`, one of the two must be set.",
+								},
+								"score_response_header_name": {
+									Type:        schema.TypeString,
 									Optional:    true,
-									Description: "The number of requests over the period of time that will trigger the Rate Limiting rule.",
+									Description: "The name of the HTTP header in the origin response that contains the score to add to the Rate Limiting count. Required with `score_per_period`.",
 								},
 								"mitigation_timeout": {
-									Type:        schema.TypeInt,
-									Optional:    true,
-									Description: "Once the request rate is reached, the Rate Limiting rule blocks further requests for the period of time defined in this field.",
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntBetween(0, 86400),
+									Description:  "Once the request rate is reached, the Rate Limiting rule blocks further requests for the period of time defined in this field.",
 								},
 								"counting_expression": {
 									Type:        schema.TypeString,
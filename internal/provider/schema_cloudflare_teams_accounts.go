@@ -33,6 +33,15 @@ func resourceCloudflareTeamsAccountSchema() map[string]*schema.Schema {
 				Schema: antivirusSchema,
 			},
 		},
+		"custom_certificate": {
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Optional:    true,
+			Description: "Configuration for the certificate used to inspect TLS traffic in place of the Cloudflare managed one.",
+			Elem: &schema.Resource{
+				Schema: customCertificateSchema,
+			},
+		},
 		"tls_decrypt_enabled": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -71,6 +80,19 @@ var fipsSchema = map[string]*schema.Schema{
 	},
 }
 
+var customCertificateSchema = map[string]*schema.Schema{
+	"enabled": {
+		Type:        schema.TypeBool,
+		Required:    true,
+		Description: "Whether TLS traffic is inspected using the custom certificate.",
+	},
+	"id": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Identifier of the uploaded certificate to use. Required when `enabled` is `true`.",
+	},
+}
+
 var blockPageSchema = map[string]*schema.Schema{
 	"enabled": {
 		Type:     schema.TypeBool,
@@ -1,6 +1,9 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 func resourceCloudflareTeamsLocationSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -33,6 +36,20 @@ func resourceCloudflareTeamsLocationSchema() map[string]*schema.Schema {
 			Type:     schema.TypeBool,
 			Optional: true,
 		},
+		"endpoints": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"doh":  teamsLocationEndpointSchema(true),
+					"dot":  teamsLocationEndpointSchema(true),
+					"ipv4": teamsLocationEndpointSchema(false),
+					"ipv6": teamsLocationEndpointSchema(true),
+				},
+			},
+		},
 		"policy_ids": {
 			Type:     schema.TypeList,
 			Elem:     &schema.Schema{Type: schema.TypeString},
@@ -46,6 +63,14 @@ func resourceCloudflareTeamsLocationSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"doh_endpoint": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"dot_endpoint": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
 		"anonymized_logs_enabled": {
 			Type:     schema.TypeBool,
 			Computed: true,
@@ -56,3 +81,35 @@ func resourceCloudflareTeamsLocationSchema() map[string]*schema.Schema {
 		},
 	}
 }
+
+// teamsLocationEndpointSchema returns the schema of a DNS endpoint of a
+// location. The IPv4 endpoint has no networks of its own as it's restricted
+// by the networks of the location.
+func teamsLocationEndpointSchema(withNetworks bool) *schema.Schema {
+	endpoint := map[string]*schema.Schema{
+		"enabled": {
+			Type:     schema.TypeBool,
+			Required: true,
+		},
+	}
+	if withNetworks {
+		endpoint["networks"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: endpoint,
+		},
+	}
+}
@@ -22,5 +22,11 @@ func resourceCloudflareTeamsProxyEndpointSchema() map[string]*schema.Schema {
 			Elem:     &schema.Schema{Type: schema.TypeString},
 			Required: true,
 		},
+		"wait_for_subdomain": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to wait on create until the API has assigned a subdomain to the proxy endpoint. Bounded by the create timeout.",
+		},
 	}
 }
@@ -6,6 +6,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// teamsRuleActionValues are the supported rule actions, including those
+// cloudflare-go doesn't know about yet.
+var teamsRuleActionValues = append(cloudflare.TeamsRulesActionValues(), teamsRuleActionResolve, teamsRuleActionQuarantine)
+
+const (
+	teamsRuleActionResolve    = "resolve"
+	teamsRuleActionQuarantine = "quarantine"
+)
+
+// teamsRuleQuarantineFileTypes are the file types which can be quarantined.
+var teamsRuleQuarantineFileTypes = []string{"exe", "pdf", "doc", "docm", "docx", "rtf", "ppt", "pptx", "xls", "xlsm", "xlsx", "zip", "rar"}
+
 func resourceCloudflareTeamsRuleSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"account_id": {
@@ -31,7 +43,7 @@ func resourceCloudflareTeamsRuleSchema() map[string]*schema.Schema {
 		},
 		"action": {
 			Type:         schema.TypeString,
-			ValidateFunc: validation.StringInSlice(cloudflare.TeamsRulesActionValues(), false),
+			ValidateFunc: validation.StringInSlice(teamsRuleActionValues, false),
 			Required:     true,
 		},
 		"filters": {
@@ -119,6 +131,104 @@ var teamsRuleSettings = map[string]*schema.Schema{
 		Type:     schema.TypeBool,
 		Optional: true,
 	},
+	"resolve_dns_through_cloudflare": {
+		Type:          schema.TypeBool,
+		Optional:      true,
+		ConflictsWith: []string{"rule_settings.0.dns_resolvers"},
+	},
+	"dns_resolvers": {
+		Type:          schema.TypeList,
+		MaxItems:      1,
+		Optional:      true,
+		ConflictsWith: []string{"rule_settings.0.resolve_dns_through_cloudflare"},
+		Elem: &schema.Resource{
+			Schema: teamsDNSResolvers,
+		},
+	},
+	"notification_settings": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: teamsNotificationSettings,
+		},
+	},
+	"quarantine": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: teamsQuarantineSettings,
+		},
+	},
+}
+
+var teamsNotificationSettings = map[string]*schema.Schema{
+	"enabled": {
+		Type:     schema.TypeBool,
+		Optional: true,
+	},
+	"msg": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"support_url": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateURL,
+	},
+}
+
+var teamsQuarantineSettings = map[string]*schema.Schema{
+	"file_types": {
+		Type:     schema.TypeSet,
+		Required: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(teamsRuleQuarantineFileTypes, false),
+		},
+	},
+}
+
+var teamsDNSResolvers = map[string]*schema.Schema{
+	"ipv4": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: teamsDNSResolverAddress(validation.IsIPv4Address),
+		},
+	},
+	"ipv6": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: teamsDNSResolverAddress(validation.IsIPv6Address),
+		},
+	},
+}
+
+func teamsDNSResolverAddress(validateIP schema.SchemaValidateFunc) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"ip": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateIP,
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      53,
+			ValidateFunc: validation.IsPortNumber,
+		},
+		"vnet_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"route_through_private_network": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+	}
 }
 
 var teamsL4OverrideSettings = map[string]*schema.Schema{
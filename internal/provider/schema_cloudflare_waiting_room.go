@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -52,18 +53,21 @@ func resourceCloudflareWaitingRoomSchema() map[string]*schema.Schema {
 		},
 
 		"host": {
-			Description: "Host name for which the waiting room will be applied (no wildcards)",
+			Description: "Host name for which the waiting room will be applied (no wildcards). Must be the zone apex or one of its subdomains.",
 			Type:        schema.TypeString,
 			Required:    true,
 			StateFunc: func(i interface{}) string {
 				return strings.ToLower(i.(string))
 			},
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^*/:\s]+$`), "must be a host name without wildcards, scheme, port or path"),
 		},
 
 		"path": {
-			Description: "The path within the host to enable the waiting room on.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:  "The path within the host to enable the waiting room on. Must begin with `/`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "/",
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "must begin with `/`"),
 		},
 
 		"total_active_users": {
@@ -63,8 +63,14 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 			ForceNew: true,
 		},
 		"content": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppressEquivalentWorkerScriptContent,
+		},
+		"content_sha256": {
+			Description: "The SHA-256 hash of the normalized script content, used to detect changes to it.",
+			Type:        schema.TypeString,
+			Computed:    true,
 		},
 		"plain_text_binding": {
 			Type:     schema.TypeSet,
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareZeroTrustDeviceDefaultProfileSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"allow_mode_switch": {
+			Description: "Whether to allow the user to switch WARP between modes.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"allow_updates": {
+			Description: "Whether to receive update notifications when a new version of the client is available.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"allowed_to_leave": {
+			Description: "Whether to allow devices to leave the organization.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"auto_connect": {
+			Description:  "The amount of time in seconds to reconnect after having been disabled. Set to `0` to disable automatic reconnection.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"captive_portal": {
+			Description:  "The amount of time in seconds to turn off WARP while the user authenticates to a captive portal.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"disable_auto_fallback": {
+			Description: "Whether to disable the fallback to the default resolver when a local domain fallback resolver is unreachable.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"exclude_office_ips": {
+			Description: "Whether to add Microsoft IPs to split tunnel exclusions.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"support_url": {
+			Description: "The URL to launch when the Send Feedback button is clicked.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
+		"switch_locked": {
+			Description: "Whether to allow the user to turn off the WARP switch and disconnect the client.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"service_mode_v2_mode": {
+			Description:  fmt.Sprintf("The service mode of the WARP client. %s", renderAvailableDocumentationValuesStringSlice(deviceServiceModes)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(deviceServiceModes, false),
+		},
+		"service_mode_v2_port": {
+			Description:  "The port to use for the proxy service mode. Required when using `service_mode_v2_mode = \"proxy\"`.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+	}
+}
+
+var deviceServiceModes = []string{"warp", "1dot1", "proxy", "posture_only", "warp_tunnel_only"}
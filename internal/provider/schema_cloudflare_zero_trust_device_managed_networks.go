@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareZeroTrustDeviceManagedNetworksSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Description: "The name of the device managed network. This name must be unique.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"type": {
+			Description:  "The type of device managed network. Available values: `tls`.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"tls"}, false),
+		},
+		"config": {
+			Description: "The configuration containing information for the WARP client to detect the managed network.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tls_sockaddr": {
+						Description:  "A network address of the form \"host:port\" that the WARP client will use to detect the presence of a TLS host.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validateSockaddr,
+					},
+					"sha256": {
+						Description:  "The SHA-256 hash of the TLS certificate presented by the host found at `tls_sockaddr`. If absent, regular certificate verification (trusted roots, valid timestamp, etc) will be used to validate the certificate.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringMatch(sha256HexRegexp, "must be a hex encoded SHA-256 hash"),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareZeroTrustGatewayCertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"validity_period_days": {
+			Description:  "Number of days the generated certificate is valid for.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      1826,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"activate": {
+			Description: "Whether Gateway uses the certificate to inspect TLS traffic.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"min_days_for_renewal": {
+			Description:  "Regenerates the certificate if terraform is run within the specified amount of days before expiration. Set `create_before_destroy` on the resource so an activated certificate is replaced without a gap.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"binding_status": {
+			Description: "The deployment status of the certificate on Cloudflare's edge.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"in_use": {
+			Description: "Whether the certificate is in use by Gateway for TLS interception.",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"certificate": {
+			Description: "The PEM encoded certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"fingerprint": {
+			Description: "The SHA-256 fingerprint of the certificate.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"created_at": {
+			Description: "When the certificate was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"expires_on": {
+			Description: "When the certificate expires.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareZeroTrustRiskBehaviorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"behavior": {
+			Description: "The risk behaviors to configure. Behaviors which aren't configured keep their current settings.",
+			Type:        schema.TypeSet,
+			Required:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Description: "The name of the risk behavior, for example `imp_travel`.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+					"enabled": {
+						Description: "Whether the behavior contributes to the risk score of users.",
+						Type:        schema.TypeBool,
+						Required:    true,
+					},
+					"risk_level": {
+						Description:  "The risk level users matching the behavior are assigned.",
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"low", "medium", "high"}, false),
+					},
+				},
+			},
+		},
+	}
+}
@@ -7,6 +7,12 @@ import (
 
 func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
+		"account_id": {
+			Description: "The account identifier to create the zone in. Defaults to the `account_id` of the provider. Moving an existing zone to another account isn't supported.",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
 		"zone": {
 			Type:             schema.TypeString,
 			Required:         true,
@@ -20,6 +26,7 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 		"paused": {
 			Type:     schema.TypeBool,
 			Optional: true,
+			Default:  false,
 		},
 		"vanity_name_servers": {
 			Type:     schema.TypeList,
@@ -42,6 +49,9 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 				planIDPartnerBusiness,
 				planIDPartnerEnterprise,
 			}, false),
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return canonicalZonePlanID(old) == canonicalZonePlanID(new)
+			},
 		},
 		"meta": {
 			Type:     schema.TypeMap,
@@ -69,8 +79,36 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 			},
 		},
 		"verification_key": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Contains the TXT record value to validate domain ownership. This is only populated for zones of type `partial`.",
+		},
+		"verification_record": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The TXT record to create at the authoritative DNS provider to verify ownership of a zone of type `partial`.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"wait_for_activation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to wait for a pending zone of type `partial` to become active during the next apply. While the zone is pending an update is planned even when the configuration is unchanged; refreshing and planning never wait. Only enable once the `verification_record` exists at the authoritative DNS provider.",
 		},
 	}
 }
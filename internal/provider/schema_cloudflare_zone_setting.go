@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	zoneSettingAutomaticPlatformOptimization = "automatic_platform_optimization"
+	zoneSettingSSLRecommender                = "ssl_recommender"
+)
+
+// granularZoneSettings are the zone settings which can be managed on their
+// own with `cloudflare_zone_setting`. Their values are validated using the
+// same rules as `cloudflare_zone_settings_override`, apart from the settings
+// in zoneSettingBlocks which are configured with their own block.
+var granularZoneSettings = []string{
+	zoneSettingAutomaticPlatformOptimization,
+	"browser_cache_ttl",
+	"cache_level",
+	zoneSettingSSLRecommender,
+}
+
+// zoneSettingBlocks are the zone settings configured with a block named after
+// the setting rather than with `value`.
+var zoneSettingBlocks = []string{
+	zoneSettingAutomaticPlatformOptimization,
+	zoneSettingSSLRecommender,
+}
+
+func resourceCloudflareZoneSettingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"zone_id": {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+
+		"setting_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(granularZoneSettings, false),
+		},
+
+		"value": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"automatic_platform_optimization": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+					"cache_by_device_type": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"cf": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"wordpress": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"wp_plugin": {
+						Type:     schema.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+					"hostnames": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"ssl_recommender": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"initial_value": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
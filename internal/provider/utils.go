@@ -2,15 +2,22 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
+	"encoding/json"
 	"fmt"
 	"hash/crc32"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -251,3 +258,102 @@ func renderAvailableDocumentationValuesStringSlice(s []string) string {
 	}
 	return output
 }
+
+// flattenTimestampRFC3339 formats an API timestamp for state. Timestamps the
+// API omitted are unmarshalled as the zero time and are flattened to an empty
+// string instead.
+func flattenTimestampRFC3339(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// validateZoneHostnames checks that every hostname is the zone apex or one of
+// its subdomains.
+func validateZoneHostnames(zoneName string, hostnames []string) error {
+	zoneName = strings.ToLower(strings.TrimSuffix(zoneName, "."))
+
+	for _, hostname := range hostnames {
+		name := strings.ToLower(strings.TrimSuffix(hostname, "."))
+		if name != zoneName && !strings.HasSuffix(name, "."+zoneName) {
+			return fmt.Errorf("hostname %q doesn't belong to zone %q", hostname, zoneName)
+		}
+	}
+
+	return nil
+}
+
+// rawAPIRequest performs a request against an endpoint that cloudflare-go does
+// not yet provide a typed method for and unmarshals the response result into
+// out. out may be nil when the result is not needed. client.Raw doesn't take a
+// context, so ctx is checked before the request is made instead; the result of
+// a request that was sent is always kept so created objects aren't lost.
+func rawAPIRequest(ctx context.Context, client *cloudflare.API, method, uri string, params interface{}, out interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	res, err := client.Raw(method, uri, params)
+	if err != nil {
+		return err
+	}
+
+	if out == nil || len(res) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(res, out); err != nil {
+		return fmt.Errorf("error unmarshalling %s %s response: %w", method, uri, err)
+	}
+
+	return nil
+}
+
+type rawResponseRecorderKey struct{}
+
+// rawResponseRecorder collects the bodies of the successful API responses
+// made with a context returned by withRawResponseRecorder.
+type rawResponseRecorder struct {
+	mu     sync.Mutex
+	bodies [][]byte
+}
+
+// withRawResponseRecorder returns a context that records the responses to the
+// requests made with it. This lets typed cloudflare-go methods, which take
+// care of pagination, hand back fields their types don't support yet.
+func withRawResponseRecorder(ctx context.Context) (context.Context, *rawResponseRecorder) {
+	recorder := &rawResponseRecorder{}
+	return context.WithValue(ctx, rawResponseRecorderKey{}, recorder), recorder
+}
+
+// rawResponseTransport records the response bodies for requests made with a
+// context returned by withRawResponseRecorder.
+type rawResponseTransport struct {
+	next http.RoundTripper
+}
+
+func (t rawResponseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.next.RoundTrip(req)
+	if err != nil || res.StatusCode >= http.StatusBadRequest {
+		return res, err
+	}
+
+	recorder, ok := req.Context().Value(rawResponseRecorderKey{}).(*rawResponseRecorder)
+	if !ok {
+		return res, nil
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	recorder.mu.Lock()
+	recorder.bodies = append(recorder.bodies, body)
+	recorder.mu.Unlock()
+
+	return res, nil
+}
@@ -3,25 +3,31 @@ package provider
 import (
 	"fmt"
 	"net"
+	"net/mail"
 	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 )
 
 var allowedHTTPMethods = []string{"GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "_ALL_"}
 var allowedSchemes = []string{"HTTP", "HTTPS", "_ALL_"}
+var sha256HexRegexp = regexp.MustCompile("^[0-9a-fA-F]{64}$")
+var hexRegexp = regexp.MustCompile("^[0-9a-fA-F]+$")
 
 // validateRecordType ensures that the cloudflare record type is valid.
 func validateRecordType(t string, proxied bool) error {
 	switch t {
 	case "A", "AAAA", "CNAME":
 		return nil
-	case "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR":
+	case "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "HTTPS", "NAPTR", "SMIMEA", "SSHFP", "SVCB", "TLSA", "URI", "PTR":
 		if ![]bool{proxied}[0] {
 			return nil
 		}
 	default:
 		return fmt.Errorf(
-			`Invalid type %q. Valid types are "A", "AAAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI" or "PTR.`, t)
+			`Invalid type %q. Valid types are "A", "AAAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CAA", "CERT", "DNSKEY", "DS", "HTTPS", "NAPTR", "SMIMEA", "SSHFP", "SVCB", "TLSA", "URI" or "PTR.`, t)
 	}
 
 	return fmt.Errorf("type %q cannot be proxied", t)
@@ -56,6 +62,127 @@ func validateRecordContent(t string, value string) error {
 	return nil
 }
 
+// dnsRecordDataFields are the `data` fields each record type supports.
+var dnsRecordDataFields = map[string][]string{
+	"CAA":    {"flags", "tag", "value"},
+	"CERT":   {"type", "key_tag", "algorithm", "certificate"},
+	"DNSKEY": {"flags", "protocol", "algorithm", "public_key"},
+	"DS":     {"key_tag", "algorithm", "digest_type", "digest"},
+	"HTTPS":  {"priority", "target", "value"},
+	"LOC": {
+		"lat_degrees", "lat_minutes", "lat_seconds", "lat_direction",
+		"long_degrees", "long_minutes", "long_seconds", "long_direction",
+		"altitude", "size", "precision_horz", "precision_vert",
+	},
+	"NAPTR":  {"order", "preference", "flags", "service", "regex", "replacement"},
+	"SMIMEA": {"usage", "selector", "matching_type", "certificate"},
+	"SRV":    {"service", "proto", "name", "priority", "weight", "port", "target"},
+	"SSHFP":  {"algorithm", "type", "fingerprint"},
+	"SVCB":   {"priority", "target", "value"},
+	"TLSA":   {"usage", "selector", "matching_type", "certificate"},
+	"URI":    {"priority", "weight", "content"},
+}
+
+// validateRecordData ensures that only the `data` fields supported by the
+// record type are set. Fields holding their zero value are treated as unset.
+func validateRecordData(t string, data map[string]interface{}) error {
+	supported, ok := dnsRecordDataFields[t]
+	if !ok {
+		return fmt.Errorf("%s records do not support `data`, use `value` instead", t)
+	}
+
+	var unsupported []string
+	for field, value := range data {
+		if contains(supported, field) || value == nil {
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			if v == "" {
+				continue
+			}
+		case int:
+			if v == 0 {
+				continue
+			}
+		case float64:
+			if v == 0 {
+				continue
+			}
+		}
+
+		unsupported = append(unsupported, field)
+	}
+
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return fmt.Errorf("%s records do not support the `data` fields %s; supported fields are %s", t, strings.Join(unsupported, ", "), strings.Join(supported, ", "))
+	}
+
+	switch t {
+	case "CAA":
+		return validateCAARecordData(data)
+	case "TLSA":
+		return validateTLSARecordData(data)
+	}
+
+	return nil
+}
+
+var caaRecordTags = []string{"issue", "issuewild", "iodef"}
+
+// validateCAARecordData ensures a CAA record has a known property tag, a
+// value and flags in the range of an unsigned 8-bit integer.
+func validateCAARecordData(data map[string]interface{}) error {
+	if flags, _ := data["flags"].(string); flags != "" {
+		if f, err := strconv.Atoi(flags); err != nil || f < 0 || f > 255 {
+			return fmt.Errorf("CAA record `data.flags` must be a number between 0 and 255, got %q", flags)
+		}
+	}
+
+	tag, _ := data["tag"].(string)
+	if !contains(caaRecordTags, tag) {
+		return fmt.Errorf("CAA record `data.tag` must be one of %s, got %q", strings.Join(caaRecordTags, ", "), tag)
+	}
+
+	if value, _ := data["value"].(string); value == "" {
+		return fmt.Errorf("CAA record `data.value` must be set")
+	}
+
+	return nil
+}
+
+// validateTLSARecordData ensures the TLSA certificate usage, selector and
+// matching type are within their registered ranges and that the certificate
+// association data is hex encoded.
+func validateTLSARecordData(data map[string]interface{}) error {
+	ranges := []struct {
+		field string
+		max   int
+	}{
+		{"usage", 3},
+		{"selector", 1},
+		{"matching_type", 2},
+	}
+	for _, r := range ranges {
+		if v, _ := data[r.field].(int); v < 0 || v > r.max {
+			return fmt.Errorf("TLSA record `data.%s` must be between 0 and %d, got %d", r.field, r.max, v)
+		}
+	}
+
+	certificate, _ := data["certificate"].(string)
+	if certificate == "" {
+		return fmt.Errorf("TLSA record `data.certificate` must be set")
+	}
+
+	if !hexRegexp.MatchString(certificate) {
+		return fmt.Errorf("TLSA record `data.certificate` must be hex encoded")
+	}
+
+	return nil
+}
+
 func validateStringIP(v interface{}, k string) (warnings []string, errors []error) {
 	ip := net.ParseIP(v.(string))
 	if ip == nil {
@@ -74,3 +201,72 @@ func validateURL(v interface{}, k string) (s []string, errors []error) {
 	}
 	return
 }
+
+// validateAPIBaseURL ensures the API base URL is an absolute URL using https.
+// Plain http is only permitted for loopback hosts so that local mocks of the
+// Cloudflare API can be targeted without TLS.
+func validateAPIBaseURL(v interface{}, k string) (s []string, errors []error) {
+	u, err := url.Parse(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+		return
+	}
+
+	if u.Host == "" {
+		errors = append(errors, fmt.Errorf("%q must be an absolute URL including the scheme and host, got: %q", k, v.(string)))
+		return
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !isLoopbackHost(u.Hostname()) {
+			errors = append(errors, fmt.Errorf("%q must use https unless targeting localhost, got: %q", k, v.(string)))
+		}
+	default:
+		errors = append(errors, fmt.Errorf("%q must use the https scheme, got: %q", k, u.Scheme))
+	}
+
+	return
+}
+
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// validateSockaddr ensures the value is a network address of the form
+// "host:port" (or "[ipv6]:port") with a valid port number.
+func validateSockaddr(v interface{}, k string) (s []string, errors []error) {
+	host, port, err := net.SplitHostPort(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be of the form \"host:port\": %w", k, err))
+		return
+	}
+
+	if host == "" {
+		errors = append(errors, fmt.Errorf("%q must include a host, got: %q", k, v.(string)))
+	}
+
+	if p, err := strconv.Atoi(port); err != nil || p < 1 || p > 65535 {
+		errors = append(errors, fmt.Errorf("%q must include a port between 1 and 65535, got: %q", k, port))
+	}
+
+	return
+}
+
+// validateEmailList ensures the value is an email address, or a comma
+// delimited list of them.
+func validateEmailList(v interface{}, k string) (s []string, errors []error) {
+	for _, email := range strings.Split(v.(string), ",") {
+		email = strings.TrimSpace(email)
+		if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+			errors = append(errors, fmt.Errorf("%q must be an email address or a comma delimited list of them, got: %q", k, email))
+		}
+	}
+	return
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"strings"
 	"testing"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -17,6 +18,9 @@ func TestValidateRecordType(t *testing.T) {
 		"MX":    cloudflare.BoolPtr(false),
 		"NS":    cloudflare.BoolPtr(false),
 		"SPF":   cloudflare.BoolPtr(false),
+		"HTTPS": cloudflare.BoolPtr(false),
+		"SVCB":  cloudflare.BoolPtr(false),
+		"SSHFP": cloudflare.BoolPtr(false),
 	}
 	for k, v := range validTypes {
 		err := validateRecordType(k, *v)
@@ -43,6 +47,106 @@ func TestValidateRecordType(t *testing.T) {
 	}
 }
 
+func TestValidateRecordData(t *testing.T) {
+	validData := map[string]map[string]interface{}{
+		"HTTPS": {"priority": 1, "target": ".", "value": `alpn="h3,h2"`, "port": 0, "proto": ""},
+		"SVCB":  {"priority": 0, "target": "svc.example.com", "value": ""},
+		"SSHFP": {"algorithm": 4, "type": 2, "fingerprint": "123456789abcdef67890123456789abcdef67890123456789abcdef123456789", "priority": 0},
+		"CAA":   {"flags": "0", "tag": "issue", "value": "letsencrypt.org"},
+		"TLSA":  {"usage": 3, "selector": 1, "matching_type": 1, "certificate": "0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6"},
+		"URI":   {"priority": 10, "weight": 1, "content": "https://example.com"},
+	}
+	for recordType, data := range validData {
+		if err := validateRecordData(recordType, data); err != nil {
+			t.Errorf("%v should be valid data for type %q: %s", data, recordType, err)
+		}
+	}
+
+	invalidData := map[string]map[string]interface{}{
+		"HTTPS": {"priority": 1, "target": ".", "fingerprint": "abc"},
+		"SVCB":  {"priority": 1, "target": ".", "port": 443},
+		"SSHFP": {"algorithm": 4, "type": 2, "value": "abc"},
+		"A":     {"value": "192.0.2.1"},
+	}
+	for recordType, data := range invalidData {
+		if err := validateRecordData(recordType, data); err == nil {
+			t.Errorf("%v should be invalid data for type %q", data, recordType)
+		}
+	}
+}
+
+func TestValidateRecordDataCAAAndTLSA(t *testing.T) {
+	testCases := map[string]struct {
+		recordType string
+		data       map[string]interface{}
+		err        string
+	}{
+		"CAA issuewild without flags": {
+			recordType: "CAA",
+			data:       map[string]interface{}{"flags": "", "tag": "issuewild", "value": ";"},
+		},
+		"CAA critical iodef": {
+			recordType: "CAA",
+			data:       map[string]interface{}{"flags": "128", "tag": "iodef", "value": "mailto:security@example.com"},
+		},
+		"CAA with out of range flags": {
+			recordType: "CAA",
+			data:       map[string]interface{}{"flags": "256", "tag": "issue", "value": "letsencrypt.org"},
+			err:        "CAA record `data.flags` must be a number between 0 and 255",
+		},
+		"CAA with an unknown tag": {
+			recordType: "CAA",
+			data:       map[string]interface{}{"flags": "0", "tag": "issues", "value": "letsencrypt.org"},
+			err:        "CAA record `data.tag` must be one of issue, issuewild, iodef",
+		},
+		"CAA without a value": {
+			recordType: "CAA",
+			data:       map[string]interface{}{"flags": "0", "tag": "issue", "value": ""},
+			err:        "CAA record `data.value` must be set",
+		},
+		"TLSA with a full certificate": {
+			recordType: "TLSA",
+			data:       map[string]interface{}{"usage": 0, "selector": 0, "matching_type": 0, "certificate": "3082"},
+		},
+		"TLSA with an out of range usage": {
+			recordType: "TLSA",
+			data:       map[string]interface{}{"usage": 4, "selector": 1, "matching_type": 1, "certificate": "3082"},
+			err:        "TLSA record `data.usage` must be between 0 and 3",
+		},
+		"TLSA with an out of range matching type": {
+			recordType: "TLSA",
+			data:       map[string]interface{}{"usage": 3, "selector": 1, "matching_type": 3, "certificate": "3082"},
+			err:        "TLSA record `data.matching_type` must be between 0 and 2",
+		},
+		"TLSA without a certificate": {
+			recordType: "TLSA",
+			data:       map[string]interface{}{"usage": 3, "selector": 1, "matching_type": 1, "certificate": ""},
+			err:        "TLSA record `data.certificate` must be set",
+		},
+		"TLSA with a certificate that isn't hex": {
+			recordType: "TLSA",
+			data:       map[string]interface{}{"usage": 3, "selector": 1, "matching_type": 1, "certificate": "not-hex"},
+			err:        "TLSA record `data.certificate` must be hex encoded",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateRecordData(tc.recordType, tc.data)
+			if tc.err == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
 func TestValidateRecordName(t *testing.T) {
 	validNames := map[string]string{
 		"A":    "192.168.0.1",
@@ -67,3 +171,84 @@ func TestValidateRecordName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateAPIBaseURL(t *testing.T) {
+	validURLs := []string{
+		"https://api.cloudflare.com/client/v4",
+		"https://cloudflare-mock.example.com",
+		"http://localhost:8080/client/v4",
+		"http://127.0.0.1:8080",
+		"http://[::1]:8080",
+	}
+	for _, v := range validURLs {
+		if _, errs := validateAPIBaseURL(v, "api_base_url"); len(errs) > 0 {
+			t.Fatalf("%q should be a valid API base URL: %v", v, errs)
+		}
+	}
+
+	invalidURLs := []string{
+		"api.cloudflare.com/client/v4",
+		"/client/v4",
+		"http://api.cloudflare.com/client/v4",
+		"ftp://localhost/client/v4",
+		"https://%zz",
+	}
+	for _, v := range invalidURLs {
+		if _, errs := validateAPIBaseURL(v, "api_base_url"); len(errs) == 0 {
+			t.Fatalf("%q should be an invalid API base URL", v)
+		}
+	}
+}
+
+func TestValidateSockaddr(t *testing.T) {
+	validAddrs := []string{
+		"foobar.com:1234",
+		"192.0.2.1:443",
+		"[2001:db8::1]:8443",
+	}
+	for _, v := range validAddrs {
+		if _, errs := validateSockaddr(v, "tls_sockaddr"); len(errs) > 0 {
+			t.Fatalf("%q should be a valid sockaddr: %v", v, errs)
+		}
+	}
+
+	invalidAddrs := []string{
+		"foobar.com",
+		":443",
+		"foobar.com:0",
+		"foobar.com:65536",
+		"foobar.com:https",
+		"2001:db8::1:443",
+	}
+	for _, v := range invalidAddrs {
+		if _, errs := validateSockaddr(v, "tls_sockaddr"); len(errs) == 0 {
+			t.Fatalf("%q should be an invalid sockaddr", v)
+		}
+	}
+}
+
+func TestValidateEmailList(t *testing.T) {
+	validEmails := []string{
+		"someone@example.com",
+		"someone@example.com,someone-else@example.com",
+		"someone@example.com, someone-else@example.com",
+	}
+	for _, v := range validEmails {
+		if _, errs := validateEmailList(v, "notification_email"); len(errs) > 0 {
+			t.Fatalf("%q should be a valid email list: %v", v, errs)
+		}
+	}
+
+	invalidEmails := []string{
+		"",
+		"someone",
+		"someone@example.com,",
+		"someone@example.com;someone-else@example.com",
+		"Someone <someone@example.com>",
+	}
+	for _, v := range invalidEmails {
+		if _, errs := validateEmailList(v, "notification_email"); len(errs) == 0 {
+			t.Fatalf("%q should be an invalid email list", v)
+		}
+	}
+}